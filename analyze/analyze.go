@@ -0,0 +1,125 @@
+package analyze
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"koutaroyumiba/wordle/bot"
+	"koutaroyumiba/wordle/config"
+)
+
+// Run handles the analyze command. with words given each of them is scored
+// and simulated, a single word also gets its feedback patterns listed.
+// without words every word in the dictionary is scored and the best by
+// entropy are simulated. -ratings regenerates the answer difficulty ratings.
+func Run(args []string, out io.Writer, cfg config.Config) error {
+	flags := flag.NewFlagSet("analyze", flag.ContinueOnError)
+	top := flags.Int("top", 10, "number of openers in the leaderboard when analysing every word")
+	patterns := flags.Int("patterns", 15, "number of feedback patterns listed for a single word")
+	ratings := flags.String("ratings", "", "rate every answer and write the ratings as go source to this file")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *ratings != "" {
+		return writeRatings(out, *ratings, cfg)
+	}
+
+	language := cfg.Lang()
+	answers := language.Answers
+	wordleBot := bot.InitBotWithWords(cfg.WordLength, cfg.MaxGuesses, language.Words)
+
+	words := make([]string, flags.NArg())
+	for i, word := range flags.Args() {
+		words[i] = language.Normalize(word)
+		if utf8.RuneCountInString(words[i]) != cfg.WordLength || !language.ValidLetters(words[i]) {
+			return fmt.Errorf("%q is not a %d letter word", word, cfg.WordLength)
+		}
+	}
+
+	var reports []bot.OpenerReport
+	if len(words) == 0 {
+		fmt.Fprintf(out, "scoring %d words against %d answers...\n", len(language.Words), len(answers))
+		reports = scoreAll(language.Words, answers)
+		bot.RankOpeners(reports)
+		reports = reports[:min(*top, len(reports))]
+	} else {
+		reports = scoreAll(words, answers)
+	}
+
+	fmt.Fprintf(out, "playing every answer with the bot after %d opener(s)...\n\n", len(reports))
+	reports = simulateAll(wordleBot, reports, answers)
+	bot.RankOpeners(reports)
+
+	writeLeaderboard(out, reports, len(answers))
+	if len(words) == 1 {
+		fmt.Fprintln(out)
+		writePatterns(out, reports[0], cfg.WordLength, *patterns)
+	}
+
+	return nil
+}
+
+// scoreAll scores the openers on every cpu, keeping their order.
+func scoreAll(words, answers []string) []bot.OpenerReport {
+	reports := make([]bot.OpenerReport, len(words))
+	parallel(len(words), func(i int) {
+		reports[i] = bot.ScoreOpener(words[i], answers)
+	})
+
+	return reports
+}
+
+func simulateAll(wordleBot bot.WordleBot, reports []bot.OpenerReport, answers []string) []bot.OpenerReport {
+	simulated := make([]bot.OpenerReport, len(reports))
+	parallel(len(reports), func(i int) {
+		simulated[i] = wordleBot.Simulate(reports[i], answers)
+	})
+
+	return simulated
+}
+
+// parallel calls work for 0 to n-1, spread over the available cpus.
+func parallel(n int, work func(i int)) {
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range runtime.GOMAXPROCS(0) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				work(i)
+			}
+		}()
+	}
+
+	for i := range n {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+func writeLeaderboard(out io.Writer, reports []bot.OpenerReport, answers int) {
+	fmt.Fprintf(out, "%-4s %-8s %8s %10s %10s %8s\n", "rank", "opener", "entropy", "remaining", "avg solve", "failed")
+	for i, r := range reports {
+		fmt.Fprintf(out, "%-4d %-8s %8.3f %10.1f %10.3f %8d\n", i+1, r.Word, r.Entropy, r.ExpectedRemaining, r.AverageSolve, r.Failures)
+	}
+	fmt.Fprintf(out, "\nentropy in bits, remaining is the expected number of the %d answers left after the opener,\n", answers)
+	fmt.Fprintln(out, "avg solve counts guesses in won games when the bot plays on after the opener")
+}
+
+func writePatterns(out io.Writer, r bot.OpenerReport, length, limit int) {
+	fmt.Fprintf(out, "feedback for %s (%d patterns, G correct, Y present, B absent):\n", r.Word, len(r.Patterns))
+	for _, p := range r.Patterns[:min(limit, len(r.Patterns))] {
+		bar := strings.Repeat("#", int(p.Probability*100+0.5))
+		fmt.Fprintf(out, "%s %6.2f%% %5d %s\n", bot.PatternCode(p.Pattern, length), p.Probability*100, p.Count, bar)
+	}
+	if rest := len(r.Patterns) - limit; rest > 0 {
+		fmt.Fprintf(out, "... %d more\n", rest)
+	}
+}
@@ -0,0 +1,62 @@
+package analyze
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+
+	"koutaroyumiba/wordle/bot"
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/lang"
+)
+
+// openers the answers are rated after, popular ones rather than only the
+// bot's best so the ratings don't depend on a single first guess
+var ratingOpeners = []string{"salet", "crane", "slate", "trace", "adieu", "audio"}
+
+// writeRatings rates every english answer and writes the ratings as the go
+// source of data/difficulty5.go to path.
+func writeRatings(out io.Writer, path string, cfg config.Config) error {
+	if cfg.Language != lang.Default || cfg.WordLength != 5 {
+		return errors.New("ratings are only generated for the 5 letter english answers")
+	}
+
+	language := cfg.Lang()
+	answers := language.Answers
+	wordleBot := bot.InitBotWithWords(cfg.WordLength, cfg.MaxGuesses, language.Words)
+
+	fmt.Fprintf(out, "playing %d answers after %d openers...\n", len(answers), len(ratingOpeners))
+	solves := make([][]int, len(ratingOpeners))
+	parallel(len(ratingOpeners), func(i int) {
+		solves[i] = wordleBot.SolveAll(ratingOpeners[i], answers)
+	})
+	ratings := bot.RateAnswers(answers, ratingOpeners, solves)
+
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by \"wordle analyze -ratings\"; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package data")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// AnswerDifficulty5 rates each of ValidAnswers5 from 0 (easiest) to 100")
+	fmt.Fprintln(&b, "// (hardest) by the guesses the bot needs after a few common openers, how")
+	fmt.Fprintln(&b, "// many answers share its feedback and how rare its letters are.")
+	fmt.Fprintln(&b, "var AnswerDifficulty5 = map[string]int{")
+	for _, r := range ratings {
+		fmt.Fprintf(&b, "\t%q: %d, // %.2f guesses, branch %d\n", r.Word, r.Score, r.AverageGuesses, r.WorstBranch)
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, src, 0644); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "wrote %d ratings to %s\n", len(ratings), path)
+	return nil
+}
@@ -1,80 +1,123 @@
 package bot
 
-import "koutaroyumiba/wordle/game"
-
-var (
-	dictionaryInputFile = "data/valid-wordle-words.txt"
-	dictionary          = game.ProcessFile(dictionaryInputFile)
+import (
+	"koutaroyumiba/wordle/data"
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/wordindex"
 )
 
+// the same list the game checks guesses against, so both share one index
+var dictionary = data.ValidWords5
+
 type WordleBot struct {
 	wordLength int
 	maxGuesses int
+	words      []string
+	index      *wordindex.Index
+	evaluator  game.Evaluator
 }
 
 func InitBot(wordLength, maxGuesses int) WordleBot {
+	return InitBotWithWords(wordLength, maxGuesses, dictionary)
+}
+
+// InitBotWithWords analyses games against another word list, e.g. for
+// another language.
+func InitBotWithWords(wordLength, maxGuesses int, words []string) WordleBot {
 	return WordleBot{
 		wordLength: wordLength,
 		maxGuesses: maxGuesses,
+		words:      words,
+		index:      wordindex.Shared(words, wordLength),
+		evaluator:  game.Standard,
 	}
 }
 
+// WithEvaluator analyses games played with other feedback rules.
+func (w WordleBot) WithEvaluator(ev game.Evaluator) WordleBot {
+	w.evaluator = ev
+	return w
+}
+
+// Analysis counts and lists the words left before each row of guesses,
+// with one more entry for the words left after the last row. the board may
+// be cut short, e.g. to the rows played so far.
 func (w WordleBot) Analysis(guesses [][]game.Cell) ([]int, [][]string) {
-	result := make([]int, w.maxGuesses)
-	wordResult := make([][]string, w.maxGuesses)
-	validWords := dictionary
-	for rowIndex := range w.maxGuesses {
-		newValidWords := []string{}
+	rows := min(len(guesses), w.maxGuesses)
+	result := make([]int, rows+1)
+	wordResult := make([][]string, rows+1)
+	validWords := w.words
+	knowledge := game.NewKnowledge(w.wordLength)
+	for rowIndex := range rows {
 		currGuess := guesses[rowIndex]
 		if rowIndex == 0 || rowIndex > 0 && len(validWords) != result[rowIndex-1] {
 			result[rowIndex] = len(validWords)
 			wordResult[rowIndex] = validWords
 		}
 
-		for _, word := range validWords {
-			if isValid(currGuess, word) {
-				newValidWords = append(newValidWords, word)
-			}
+		// no word fits a row that hasn't been played
+		if !played(currGuess) {
+			validWords = []string{}
+			continue
 		}
-
-		validWords = newValidWords
+		if w.evaluator != game.Standard {
+			validWords = w.consistent(validWords, currGuess)
+			continue
+		}
+		knowledge.AddRow(currGuess)
+		validWords = w.index.Filter(knowledge.Constraints())
 	}
+	result[rows], wordResult[rows] = len(validWords), validWords
 
 	return result, wordResult
 }
 
-func isValid(guess []game.Cell, word string) bool {
-	guessResult := make([]game.CellState, len(guess))
-	answerRunes := []rune(word)
-	counts := map[rune]int{}
-
-	// find all green
-	for i := range guess {
-		char, _ := guess[i].GetInfo()
-		if answerRunes[i] == char {
-			guessResult[i] = game.StateCorrect
-		} else {
-			counts[answerRunes[i]]++
+// Candidates filters the word list down to the words that fit the
+// feedback of the first played rows of guesses.
+func (w WordleBot) Candidates(guesses [][]game.Cell, played int) []string {
+	if played == 0 {
+		return w.words
+	}
+	if w.evaluator != game.Standard {
+		words := w.words
+		for _, row := range guesses[:played] {
+			words = w.consistent(words, row)
 		}
+		return words
 	}
 
-	// second pass (for yellow)
-	for i := range guess {
-		char, _ := guess[i].GetInfo()
-		if guessResult[i] == game.StateCorrect {
-			continue
-		}
-		if counts[char] > 0 {
-			guessResult[i] = game.StatePresent
-			counts[char]--
-		} else {
-			guessResult[i] = game.StateAbsent
+	return w.Filter(game.KnowledgeFrom(guesses, played).Constraints())
+}
+
+// Filter is the words that fit the constraints, e.g. the feedback so far
+// together with the letters typed into the current row.
+func (w WordleBot) Filter(c wordindex.Constraints) []string {
+	return w.index.Filter(c)
+}
+
+// consistent keeps the words that could have given the feedback of row,
+// for rules the index can't express.
+func (w WordleBot) consistent(words []string, row []game.Cell) []string {
+	guess := make([]rune, len(row))
+	feedback := make([]game.CellState, len(row))
+	for i, c := range row {
+		guess[i], feedback[i] = c.GetInfo()
+	}
+
+	kept := []string{}
+	for _, word := range words {
+		answer := []rune(word)
+		if len(answer) == len(guess) && w.evaluator.Consistent(answer, guess, feedback) {
+			kept = append(kept, word)
 		}
 	}
 
-	for i := range guessResult {
-		_, state := guess[i].GetInfo()
-		if guessResult[i] != state {
+	return kept
+}
+
+func played(row []game.Cell) bool {
+	for _, c := range row {
+		if _, state := c.GetInfo(); state == game.StateEmpty {
 			return false
 		}
 	}
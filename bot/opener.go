@@ -0,0 +1,168 @@
+package bot
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// PatternShare is how many answers give one feedback pattern.
+type PatternShare struct {
+	Pattern     int
+	Count       int
+	Probability float64
+}
+
+// OpenerReport is how well a starting word does against a list of answers.
+type OpenerReport struct {
+	Word    string
+	Entropy float64
+	// average number of answers left after the opener's feedback
+	ExpectedRemaining float64
+	// feedback patterns, most likely first
+	Patterns []PatternShare
+	// set by Simulate: guesses needed on average (wins only) when the bot
+	// plays on after the opener, and how many answers it failed to find
+	AverageSolve float64
+	Failures     int
+	Simulated    bool
+}
+
+// ScoreOpener works out the entropy, expected remaining answers and
+// pattern probabilities of opening with word.
+func ScoreOpener(word string, answers []string) OpenerReport {
+	guess := []rune(word)
+	buckets := map[int]int{}
+	for _, answer := range answers {
+		buckets[Pattern(guess, []rune(answer))]++
+	}
+
+	report := OpenerReport{Word: word, Entropy: entropyOf(slices.Collect(maps.Values(buckets)), len(answers))}
+	for pattern, n := range buckets {
+		p := float64(n) / float64(len(answers))
+		report.Patterns = append(report.Patterns, PatternShare{Pattern: pattern, Count: n, Probability: p})
+	}
+	slices.SortFunc(report.Patterns, func(a, b PatternShare) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Pattern, b.Pattern)
+	})
+	for _, p := range report.Patterns {
+		report.ExpectedRemaining += float64(p.Count) * p.Probability
+	}
+
+	return report
+}
+
+// Simulate plays every answer starting with the report's word and then the
+// bot's suggestions, filling in the average solve length and failures.
+func (w WordleBot) Simulate(report OpenerReport, answers []string) OpenerReport {
+	solved, total := 0, 0
+	report.Failures = 0
+	for _, guesses := range w.SolveAll(report.Word, answers) {
+		if guesses > w.maxGuesses {
+			report.Failures++
+			continue
+		}
+		solved++
+		total += guesses
+	}
+
+	if solved > 0 {
+		report.AverageSolve = float64(total) / float64(solved)
+	}
+	report.Simulated = true
+	return report
+}
+
+// SolveAll plays every answer starting with opener and then the bot's
+// suggestions and returns the guesses each one took, maxGuesses+1 for the
+// answers the bot failed.
+func (w WordleBot) SolveAll(opener string, answers []string) []int {
+	// the bot always picks the same guess for the same feedback, so the
+	// guesses after each feedback history are worked out once
+	next := map[string]string{}
+	pools := map[string][]string{}
+
+	results := make([]int, len(answers))
+	for i, answer := range answers {
+		target := []rune(answer)
+		guess, history, candidates := opener, "", w.words
+
+		results[i] = w.maxGuesses + 1
+		for attempt := 1; attempt <= w.maxGuesses; attempt++ {
+			pattern := Pattern([]rune(guess), target)
+			if guess == answer {
+				results[i] = attempt
+				break
+			}
+
+			history += fmt.Sprintf("%s:%d;", guess, pattern)
+			if pool, ok := pools[history]; ok {
+				candidates = pool
+			} else {
+				candidates = filterByPattern(candidates, []rune(guess), pattern)
+				pools[history] = candidates
+			}
+
+			suggestion, ok := next[history]
+			if !ok {
+				suggestion = w.Suggest(candidates)
+				next[history] = suggestion
+			}
+			if suggestion == "" {
+				break
+			}
+			guess = suggestion
+		}
+	}
+
+	return results
+}
+
+// filterByPattern keeps the words that would give pattern for guess, the
+// same words Candidates keeps for that row.
+func filterByPattern(words []string, guess []rune, pattern int) []string {
+	kept := []string{}
+	for _, word := range words {
+		if Pattern(guess, []rune(word)) == pattern {
+			kept = append(kept, word)
+		}
+	}
+
+	return kept
+}
+
+// PatternCode spells out a pattern from Pattern as G (correct), Y (present)
+// and B (absent), e.g. "BBGYB".
+func PatternCode(pattern, length int) string {
+	code := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		code[i] = "BYG"[pattern%3]
+		pattern /= 3
+	}
+
+	return string(code)
+}
+
+// RankOpeners orders reports best first: fewest guesses to solve when they
+// were simulated, then most information from the opener.
+func RankOpeners(reports []OpenerReport) {
+	slices.SortStableFunc(reports, func(a, b OpenerReport) int {
+		if a.Simulated && b.Simulated {
+			if c := cmp.Compare(a.Failures, b.Failures); c != 0 {
+				return c
+			}
+			if c := cmp.Compare(a.AverageSolve, b.AverageSolve); c != 0 {
+				return c
+			}
+		}
+		if c := cmp.Compare(b.Entropy, a.Entropy); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Word, b.Word)
+	})
+}
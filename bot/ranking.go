@@ -0,0 +1,27 @@
+package bot
+
+import (
+	"cmp"
+	"slices"
+)
+
+// GuessScore is how much a guess is expected to tell about the answer.
+type GuessScore struct {
+	Word    string
+	Entropy float64
+}
+
+// RankGuesses scores every word as the next guess against candidates, best
+// first. unlike Suggest nothing is left out, so any guess can be looked up.
+func (w WordleBot) RankGuesses(candidates []string) []GuessScore {
+	answers := toRunes(candidates)
+	ranking := make([]GuessScore, len(w.words))
+	for i, word := range w.words {
+		ranking[i] = GuessScore{Word: word, Entropy: Entropy([]rune(word), answers)}
+	}
+
+	slices.SortStableFunc(ranking, func(a, b GuessScore) int {
+		return cmp.Compare(b.Entropy, a.Entropy)
+	})
+	return ranking
+}
@@ -0,0 +1,114 @@
+package bot
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// AnswerRating is how hard one answer is to find.
+type AnswerRating struct {
+	Word string
+	// guesses the bot needed on average over the openers, a failure
+	// counting as maxGuesses+1
+	AverageGuesses float64
+	// most answers left with the same feedback after any of the openers,
+	// large for families like _IGHT
+	WorstBranch int
+	// 0..1, how unusual the letters are among the answers
+	Rarity float64
+	// 0 (easiest) to 100 (hardest), the share of answers rated easier
+	Score int
+}
+
+// RateAnswers combines the bot's results on answers after each opener,
+// solves[i] being SolveAll(openers[i], answers), with the rarity of their
+// letters into a score.
+func RateAnswers(answers, openers []string, solves [][]int) []AnswerRating {
+	ratings := make([]AnswerRating, len(answers))
+	for i, answer := range answers {
+		ratings[i].Word = answer
+		for _, results := range solves {
+			ratings[i].AverageGuesses += float64(results[i])
+		}
+		if len(solves) > 0 {
+			ratings[i].AverageGuesses /= float64(len(solves))
+		}
+	}
+
+	for _, opener := range openers {
+		patterns := make([]int, len(answers))
+		buckets := map[int]int{}
+		for i, answer := range answers {
+			patterns[i] = Pattern([]rune(opener), []rune(answer))
+			buckets[patterns[i]]++
+		}
+		for i := range answers {
+			ratings[i].WorstBranch = max(ratings[i].WorstBranch, buckets[patterns[i]])
+		}
+	}
+
+	// a letter is as common as the share of answers it appears in
+	share := map[rune]float64{}
+	for _, answer := range answers {
+		seen := map[rune]bool{}
+		for _, char := range answer {
+			if !seen[char] {
+				seen[char] = true
+				share[char] += 1 / float64(len(answers))
+			}
+		}
+	}
+	for i, answer := range answers {
+		letters := []rune(answer)
+		for _, char := range letters {
+			ratings[i].Rarity += 1 - share[char]
+		}
+		ratings[i].Rarity /= float64(len(letters))
+	}
+
+	// the bot's guesses count most, the branch says how much luck it takes
+	// and rare letters are what trips up people rather than the bot
+	guesses := normalize(ratings, func(r AnswerRating) float64 { return r.AverageGuesses })
+	branch := normalize(ratings, func(r AnswerRating) float64 { return math.Log(float64(r.WorstBranch)) })
+	rarity := normalize(ratings, func(r AnswerRating) float64 { return r.Rarity })
+	raw := make([]float64, len(ratings))
+	for i := range ratings {
+		raw[i] = 0.6*guesses[i] + 0.25*branch[i] + 0.15*rarity[i]
+	}
+
+	order := make([]int, len(ratings))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(raw[a], raw[b])
+	})
+	for rank, i := range order {
+		if len(order) > 1 {
+			ratings[i].Score = int(math.Round(100 * float64(rank) / float64(len(order)-1)))
+		}
+	}
+
+	return ratings
+}
+
+// normalize scales value over the ratings to 0..1.
+func normalize(ratings []AnswerRating, value func(AnswerRating) float64) []float64 {
+	out := make([]float64, len(ratings))
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for i, r := range ratings {
+		out[i] = value(r)
+		lo, hi = min(lo, out[i]), max(hi, out[i])
+	}
+
+	for i := range out {
+		if hi > lo {
+			out[i] = (out[i] - lo) / (hi - lo)
+		} else {
+			out[i] = 0
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,22 @@
+package bot
+
+import (
+	"slices"
+
+	"koutaroyumiba/wordle/game"
+)
+
+// PatternSolutions are the words that give pattern as the feedback against
+// answer, the solutions of a reverse wordle row.
+func (w WordleBot) PatternSolutions(answer string, pattern []game.CellState) []string {
+	target := []rune(answer)
+	solutions := []string{}
+	for _, word := range w.words {
+		guess := []rune(word)
+		if len(guess) == len(target) && slices.Equal(game.EvaluateGuess(target, guess), pattern) {
+			solutions = append(solutions, word)
+		}
+	}
+
+	return solutions
+}
@@ -0,0 +1,103 @@
+package bot
+
+import (
+	"maps"
+	"math"
+	"slices"
+
+	"koutaroyumiba/wordle/game"
+)
+
+const (
+	// scoring every word against every candidate is too slow early in the
+	// game, so both sides are thinned out evenly to at most these sizes
+	suggestGuessLimit  = 300
+	suggestAnswerLimit = 1000
+)
+
+// Suggest picks the candidate that is expected to split the remaining
+// candidates the most, i.e. the one whose feedback has the highest entropy.
+func (w WordleBot) Suggest(candidates []string) string {
+	if len(candidates) == 0 {
+		return ""
+	}
+	if len(candidates) <= 2 {
+		return candidates[0]
+	}
+
+	answers := toRunes(sample(candidates, suggestAnswerLimit))
+	best, bestScore := "", -1.0
+	for _, guess := range sample(candidates, suggestGuessLimit) {
+		score := Entropy([]rune(guess), answers)
+		if score > bestScore {
+			best, bestScore = guess, score
+		}
+	}
+
+	return best
+}
+
+// Entropy is the expected information in bits the feedback for guess gives
+// when the answer is equally likely to be any of answers.
+func Entropy(guess []rune, answers [][]rune) float64 {
+	buckets := map[int]int{}
+	for _, answer := range answers {
+		buckets[Pattern(guess, answer)]++
+	}
+
+	return entropyOf(slices.Collect(maps.Values(buckets)), len(answers))
+}
+
+// entropyOf sums in a fixed order, so the same buckets always give exactly
+// the same score and ties are broken the same way every time.
+func entropyOf(counts []int, total int) float64 {
+	slices.Sort(counts)
+	entropy := 0.0
+	for _, n := range counts {
+		p := float64(n) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
+
+// Pattern encodes the feedback for guess against answer as a base 3
+// number, one digit per letter, so feedback can be compared and counted.
+func Pattern(guess, answer []rune) int {
+	code := 0
+	for _, state := range game.EvaluateGuess(answer, guess) {
+		code *= 3
+		switch state {
+		case game.StatePresent:
+			code += 1
+		case game.StateCorrect:
+			code += 2
+		}
+	}
+
+	return code
+}
+
+// sample keeps at most limit words spread evenly over words.
+func sample(words []string, limit int) []string {
+	if len(words) <= limit {
+		return words
+	}
+
+	out := make([]string, limit)
+	step := float64(len(words)) / float64(limit)
+	for i := range limit {
+		out[i] = words[int(float64(i)*step)]
+	}
+
+	return out
+}
+
+func toRunes(words []string) [][]rune {
+	out := make([][]rune, len(words))
+	for i, word := range words {
+		out[i] = []rune(word)
+	}
+
+	return out
+}
@@ -0,0 +1,352 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"os/user"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"koutaroyumiba/wordle/data"
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/lang"
+)
+
+const (
+	appDir     = "terminal-wordle"
+	configFile = "config.json"
+
+	MinGuesses      = 1
+	MaxGuessesLimit = 20
+)
+
+var (
+	// word lengths we have answer and dictionary lists for
+	WordLengths     = []int{5}
+	Themes          = []string{"dark", "light", "high-contrast"}
+	AssistantLevels = []string{"off", "count", "candidates", "suggestion"}
+	KeyboardLayouts = []string{"qwerty", "azerty", "qwertz", "dvorak", "colemak", "alphabetical", "spanish", "german"}
+
+	Picks = []string{game.PickRandom, game.PickEasy, game.PickHard}
+	// what easy and hard picks are based on
+	DifficultySources = []string{"frequency", "history", "rating"}
+	// answer difficulty tiers a game can be limited to
+	Tiers = append([]string{game.TierAny}, game.Tiers...)
+)
+
+type Config struct {
+	Language          string `json:"language"`
+	WordLength        int    `json:"word_length"`
+	MaxGuesses        int    `json:"max_guesses"`
+	Theme             string `json:"theme"`
+	KeyboardLayout    string `json:"keyboard_layout"`
+	StatsPath         string `json:"stats_path"`
+	EnforceDictionary bool   `json:"enforce_dictionary"`
+	HardMode          bool   `json:"hard_mode"`
+	Animations        bool   `json:"animations"`
+	// how much the bot helps during a game, one of AssistantLevels
+	Assistant string `json:"assistant"`
+	// a player name, each profile keeps its own stats and played answers
+	Profile string `json:"profile,omitempty"`
+	// file of answers never to pick, one per line
+	ExcludeAnswers string `json:"exclude_answers,omitempty"`
+	// how answers are drawn, one of Picks, based on one of DifficultySources
+	Pick       string `json:"pick"`
+	Difficulty string `json:"difficulty"`
+	// only answers rated in this tier, one of Tiers
+	Tier string `json:"tier"`
+	// the feedback variant, one of game.RuleNames
+	Rules string `json:"rules"`
+	// extra keyboard layouts by name, one string of letters per row
+	CustomLayouts map[string][]string `json:"custom_layouts,omitempty"`
+	// address of the team leaderboard server and the key results are
+	// signed with
+	Leaderboard    string `json:"leaderboard,omitempty"`
+	LeaderboardKey string `json:"leaderboard_key,omitempty"`
+
+	// play today's daily puzzle first, only set from the command line
+	Daily bool `json:"-"`
+	// start with a reverse wordle puzzle, only set from the command line
+	Reverse bool `json:"-"`
+}
+
+func Default() Config {
+	return Config{
+		Language:          lang.Default,
+		WordLength:        5,
+		MaxGuesses:        6,
+		Theme:             "dark",
+		KeyboardLayout:    "qwerty",
+		StatsPath:         "stats.json",
+		Pick:              game.PickRandom,
+		Difficulty:        "frequency",
+		Tier:              game.TierAny,
+		Rules:             game.RulesStandard,
+		EnforceDictionary: true,
+		Animations:        true,
+		Assistant:         "off",
+	}
+}
+
+// DefaultPath is config.json inside the user's config directory,
+// e.g. ~/.config/terminal-wordle/config.json on linux.
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, appDir, configFile), nil
+}
+
+// Load reads the config at path on top of the defaults, so the file only
+// needs the settings that differ. a missing file is not an error.
+func Load(path string) (Config, error) {
+	c := Default()
+
+	f, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return c, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(f))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&c); err != nil {
+		return c, fmt.Errorf("%s: %w", path, describeDecodeError(f, err))
+	}
+
+	if err := c.Validate(); err != nil {
+		return c, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return c, nil
+}
+
+func (c Config) Save(path string) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Validate reports every invalid setting at once.
+func (c Config) Validate() error {
+	var errs []error
+
+	language, ok := lang.Get(c.Language)
+	if !ok {
+		errs = append(errs, fmt.Errorf("language must be one of %s (got %q)", strings.Join(lang.Codes(), ", "), c.Language))
+	}
+	if !slices.Contains(WordLengths, c.WordLength) {
+		errs = append(errs, fmt.Errorf("word_length must be one of %v (got %d)", WordLengths, c.WordLength))
+	}
+	if c.MaxGuesses < MinGuesses || c.MaxGuesses > MaxGuessesLimit {
+		errs = append(errs, fmt.Errorf("max_guesses must be between %d and %d (got %d)", MinGuesses, MaxGuessesLimit, c.MaxGuesses))
+	}
+	if !slices.Contains(Themes, c.Theme) {
+		errs = append(errs, fmt.Errorf("theme must be one of %s (got %q)", strings.Join(Themes, ", "), c.Theme))
+	}
+	if ok {
+		if err := c.checkLayout(language.Alphabet); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if names := c.LayoutNames(); !slices.Contains(names, c.KeyboardLayout) {
+		errs = append(errs, fmt.Errorf("keyboard_layout must be one of %s (got %q)", strings.Join(names, ", "), c.KeyboardLayout))
+	}
+	if !slices.Contains(AssistantLevels, c.Assistant) {
+		errs = append(errs, fmt.Errorf("assistant must be one of %s (got %q)", strings.Join(AssistantLevels, ", "), c.Assistant))
+	}
+	if !slices.Contains(Picks, c.Pick) {
+		errs = append(errs, fmt.Errorf("pick must be one of %s (got %q)", strings.Join(Picks, ", "), c.Pick))
+	}
+	if !slices.Contains(DifficultySources, c.Difficulty) {
+		errs = append(errs, fmt.Errorf("difficulty must be one of %s (got %q)", strings.Join(DifficultySources, ", "), c.Difficulty))
+	}
+	if !slices.Contains(Tiers, c.Tier) {
+		errs = append(errs, fmt.Errorf("tier must be one of %s (got %q)", strings.Join(Tiers, ", "), c.Tier))
+	}
+	if !slices.Contains(game.RuleNames, c.Rules) {
+		errs = append(errs, fmt.Errorf("rules must be one of %s (got %q)", strings.Join(game.RuleNames, ", "), c.Rules))
+	} else if c.HardMode && c.Rules != game.RulesStandard {
+		errs = append(errs, fmt.Errorf("hard_mode only works with the standard rules (got %q)", c.Rules))
+	}
+	if c.Profile != "" && strings.ContainsFunc(c.Profile, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_'
+	}) {
+		errs = append(errs, fmt.Errorf("profile may only use letters, digits, - and _ (got %q)", c.Profile))
+	}
+	if c.ExcludeAnswers != "" {
+		exclude, err := game.ReadWordList(c.ExcludeAnswers)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("exclude_answers: %w", err))
+		} else if ok && len(game.UnplayedAnswers(language.Answers, nil, exclude)) == 0 {
+			errs = append(errs, fmt.Errorf("exclude_answers leaves no %s answers to pick", language.Name))
+		}
+	}
+	if c.Leaderboard != "" {
+		if u, err := url.Parse(c.Leaderboard); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("leaderboard must be an http or https address (got %q)", c.Leaderboard))
+		} else if c.LeaderboardKey == "" {
+			errs = append(errs, errors.New("leaderboard_key must be set to submit results to the leaderboard"))
+		}
+	}
+	if c.StatsPath == "" {
+		errs = append(errs, errors.New("stats_path must not be empty"))
+	} else if dir := filepath.Dir(c.StatsPath); dir != "." {
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			errs = append(errs, fmt.Errorf("stats_path directory %q does not exist", dir))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Lang is the selected language, english if it is unknown.
+func (c Config) Lang() lang.Language {
+	if l, ok := lang.Get(c.Language); ok {
+		return l
+	}
+
+	l, _ := lang.Get(lang.Default)
+	return l
+}
+
+// StatsFile is where the stats of the profile are kept: stats_path, with
+// the profile name added when there is one (stats-alice.json).
+func (c Config) StatsFile() string {
+	if c.Profile == "" {
+		return c.StatsPath
+	}
+
+	ext := filepath.Ext(c.StatsPath)
+	return strings.TrimSuffix(c.StatsPath, ext) + "-" + c.Profile + ext
+}
+
+// DrillsFile keeps the training drill progress of the profile next to the
+// stats, drills.json or drills-alice.json.
+func (c Config) DrillsFile() string {
+	name := "drills.json"
+	if c.Profile != "" {
+		name = "drills-" + c.Profile + ".json"
+	}
+
+	return filepath.Join(filepath.Dir(c.StatsPath), name)
+}
+
+// NewGame starts a game with an answer the profile hasn't played yet,
+// drawn using these settings. in daily mode that is today's puzzle until
+// it has been played.
+func (c Config) NewGame() game.GameState {
+	stats, _ := game.ReadStatsFile(c.StatsFile())
+	if day := game.DailyNumber(time.Now()); c.Daily && !game.PlayedDaily(stats.History, day) {
+		// everyone plays the daily by the same rules: the standard board and
+		// feedback, only words from the list and no hard mode
+		game.SetStatsPath(c.StatsFile())
+		g := game.InitGameWithWord(game.DailyWordLength, game.DailyMaxGuesses, game.DailyAnswer(c.Lang().Answers, day))
+		g.SetDictionary(c.Lang().Words)
+		g.SetDaily(day)
+		return g
+	}
+
+	answer := game.PickAnswer(c.Lang().Answers, stats.History, c.poolOptions(stats.History))
+	return c.NewGameWithWord(answer)
+}
+
+// Evaluator gives the feedback of the configured rules.
+func (c Config) Evaluator() game.Evaluator {
+	ev, ok := game.RulesFor(c.Rules)
+	if !ok {
+		return game.Standard
+	}
+	return ev
+}
+
+// PlayerName is the name results are submitted under: the profile, or the
+// user's login name without one.
+func (c Config) PlayerName() string {
+	if c.Profile != "" {
+		return c.Profile
+	}
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+
+	return "player"
+}
+
+func (c Config) poolOptions(history []game.GameRecord) game.PoolOptions {
+	opts := game.PoolOptions{Pick: c.Pick, Tier: c.Tier}
+	if c.ExcludeAnswers != "" {
+		// checked by Validate
+		opts.Exclude, _ = game.ReadWordList(c.ExcludeAnswers)
+	}
+
+	switch c.Difficulty {
+	case "frequency":
+		opts.Difficulty = game.FrequencyDifficulty(data.CommonWords5)
+	case "history":
+		opts.Difficulty = game.HistoryDifficulty(history, c.MaxGuesses)
+	case "rating":
+		opts.Difficulty = game.RatingDifficulty
+	}
+
+	return opts
+}
+
+// NewGameWithWord is NewGame with a fixed answer.
+func (c Config) NewGameWithWord(word string) game.GameState {
+	game.SetStatsPath(c.StatsFile())
+	g := game.InitGameWithWord(c.WordLength, c.MaxGuesses, word)
+	g.SetDictionary(c.Lang().Words)
+	g.SetAllowDictionary(c.EnforceDictionary)
+	g.SetHardMode(c.HardMode)
+	g.SetEvaluator(c.Evaluator())
+
+	return g
+}
+
+// describeDecodeError points syntax and type errors at a line number,
+// which is a lot easier to act on than a byte offset.
+func describeDecodeError(data []byte, err error) error {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	switch {
+	case errors.As(err, &syntaxErr):
+		return fmt.Errorf("line %d: %v", lineOf(data, syntaxErr.Offset), syntaxErr)
+	case errors.As(err, &typeErr):
+		return fmt.Errorf("line %d: %s must be of type %s (got %s)", lineOf(data, typeErr.Offset), typeErr.Field, typeErr.Type, typeErr.Value)
+	}
+
+	return errors.New(strings.TrimPrefix(err.Error(), "json: "))
+}
+
+func lineOf(data []byte, offset int64) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+
+	return strings.Count(string(data[:offset]), "\n") + 1
+}
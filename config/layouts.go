@@ -0,0 +1,111 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+var builtinLayouts = map[string][]string{
+	"qwerty":       {"qwertyuiop", "asdfghjkl", "zxcvbnm"},
+	"azerty":       {"azertyuiop", "qsdfghjklm", "wxcvbn"},
+	"qwertz":       {"qwertzuiop", "asdfghjkl", "yxcvbnm"},
+	"dvorak":       {"pyfgcrl", "aoeuidhtns", "qjkxbmwvz"},
+	"colemak":      {"qwfpgjluy", "arstdhneio", "zxcvbkm"},
+	"alphabetical": {"abcdefghi", "jklmnopqr", "stuvwxyz"},
+	"spanish":      {"qwertyuiop", "asdfghjklñ", "zxcvbnm"},
+	"german":       {"qwertzuiopü", "asdfghjklöä", "yxcvbnm"},
+}
+
+// LayoutNames lists the built-in keyboard layouts followed by the user's
+// custom ones.
+func (c Config) LayoutNames() []string {
+	names := slices.Clone(KeyboardLayouts)
+	for _, name := range slices.Sorted(maps.Keys(c.CustomLayouts)) {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// LayoutRows returns the rows of the selected keyboard layout, falling
+// back to qwerty for unknown names. letters of the language's alphabet the
+// layout lacks are added as an extra row so they can still be clicked.
+func (c Config) LayoutRows() []string {
+	rows, ok := c.CustomLayouts[c.KeyboardLayout]
+	if !ok {
+		rows, ok = builtinLayouts[c.KeyboardLayout]
+	}
+	if !ok {
+		rows = builtinLayouts["qwerty"]
+	}
+
+	missing := []rune{}
+	for _, ch := range c.Lang().Alphabet {
+		if !slices.ContainsFunc(rows, func(row string) bool { return strings.ContainsRune(row, ch) }) {
+			missing = append(missing, ch)
+		}
+	}
+	if len(missing) > 0 {
+		rows = append(slices.Clone(rows), string(missing))
+	}
+
+	return rows
+}
+
+// CheckLayout reports whether the selected keyboard layout, if it is a
+// custom one, fits the language. custom layouts that aren't selected may be
+// for other languages.
+func (c Config) CheckLayout() error {
+	return c.checkLayout(c.Lang().Alphabet)
+}
+
+func (c Config) checkLayout(alphabet []rune) error {
+	rows, ok := c.CustomLayouts[c.KeyboardLayout]
+	if !ok {
+		return nil
+	}
+
+	return validateLayout(c.KeyboardLayout, rows, alphabet)
+}
+
+// validateLayout checks a custom layout covers every letter of the
+// alphabet exactly once.
+func validateLayout(name string, rows []string, alphabet []rune) error {
+	if len(rows) == 0 {
+		return fmt.Errorf("custom layout %q has no rows", name)
+	}
+
+	var errs []error
+	seen := map[rune]bool{}
+	for i, row := range rows {
+		if row == "" {
+			errs = append(errs, fmt.Errorf("custom layout %q: row %d is empty", name, i+1))
+		}
+		for _, ch := range row {
+			switch {
+			case !slices.Contains(alphabet, ch):
+				errs = append(errs, fmt.Errorf("custom layout %q: %q is not a letter of the alphabet", name, ch))
+			case seen[ch]:
+				errs = append(errs, fmt.Errorf("custom layout %q: %q appears more than once", name, ch))
+			}
+			seen[ch] = true
+		}
+	}
+
+	missing := []string{}
+	for _, ch := range alphabet {
+		if !seen[ch] {
+			missing = append(missing, string(ch))
+		}
+	}
+	if len(missing) > 0 {
+		errs = append(errs, fmt.Errorf("custom layout %q is missing %s", name, strings.Join(missing, ", ")))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,10 @@
+package data
+
+import _ "embed"
+
+// DefinitionsGz is a gzipped tab separated list of the english answers with
+// a short definition and where the word comes from, one answer per line.
+// edit definitions.tsv and run go generate to rebuild it.
+//
+//go:embed definitions.tsv.gz
+var DefinitionsGz []byte
@@ -0,0 +1,2324 @@
+// Code generated by "wordle analyze -ratings"; DO NOT EDIT.
+
+package data
+
+// AnswerDifficulty5 rates each of ValidAnswers5 from 0 (easiest) to 100
+// (hardest) by the guesses the bot needs after a few common openers, how
+// many answers share its feedback and how rare its letters are.
+var AnswerDifficulty5 = map[string]int{
+	"aback": 48,  // 4.50 guesses, branch 102
+	"abase": 5,   // 4.00 guesses, branch 47
+	"abate": 1,   // 3.50 guesses, branch 47
+	"abbey": 19,  // 4.17 guesses, branch 61
+	"abbot": 17,  // 3.67 guesses, branch 134
+	"abhor": 27,  // 4.00 guesses, branch 136
+	"abide": 3,   // 3.33 guesses, branch 83
+	"abled": 3,   // 3.67 guesses, branch 48
+	"abode": 3,   // 3.33 guesses, branch 83
+	"abort": 2,   // 3.33 guesses, branch 65
+	"about": 10,  // 3.50 guesses, branch 134
+	"above": 23,  // 4.17 guesses, branch 83
+	"abuse": 1,   // 3.33 guesses, branch 49
+	"abyss": 30,  // 4.00 guesses, branch 134
+	"acorn": 11,  // 3.67 guesses, branch 136
+	"acrid": 6,   // 3.33 guesses, branch 136
+	"actor": 3,   // 3.67 guesses, branch 51
+	"acute": 0,   // 3.50 guesses, branch 20
+	"adage": 5,   // 3.67 guesses, branch 83
+	"adapt": 7,   // 4.00 guesses, branch 49
+	"adept": 2,   // 3.50 guesses, branch 46
+	"admin": 8,   // 3.33 guesses, branch 136
+	"admit": 11,  // 3.50 guesses, branch 134
+	"adobe": 4,   // 3.50 guesses, branch 83
+	"adopt": 36,  // 4.17 guesses, branch 134
+	"adore": 14,  // 4.17 guesses, branch 83
+	"adorn": 39,  // 4.33 guesses, branch 136
+	"adult": 2,   // 2.83 guesses, branch 134
+	"affix": 65,  // 4.50 guesses, branch 136
+	"afire": 7,   // 3.83 guesses, branch 83
+	"afoot": 33,  // 4.17 guesses, branch 134
+	"afoul": 16,  // 3.67 guesses, branch 134
+	"after": 2,   // 3.67 guesses, branch 50
+	"again": 8,   // 3.67 guesses, branch 102
+	"agape": 5,   // 3.67 guesses, branch 83
+	"agate": 4,   // 4.00 guesses, branch 47
+	"agent": 4,   // 3.83 guesses, branch 47
+	"agile": 1,   // 3.17 guesses, branch 49
+	"aging": 48,  // 4.33 guesses, branch 136
+	"aglow": 62,  // 4.67 guesses, branch 134
+	"agony": 30,  // 4.00 guesses, branch 136
+	"agora": 51,  // 4.67 guesses, branch 136
+	"agree": 1,   // 3.33 guesses, branch 47
+	"ahead": 17,  // 4.17 guesses, branch 83
+	"aider": 6,   // 4.00 guesses, branch 61
+	"aisle": 0,   // 3.00 guesses, branch 49
+	"alarm": 4,   // 3.67 guesses, branch 67
+	"album": 19,  // 3.67 guesses, branch 134
+	"alert": 2,   // 3.67 guesses, branch 50
+	"algae": 3,   // 3.83 guesses, branch 49
+	"alibi": 33,  // 4.17 guesses, branch 134
+	"alien": 1,   // 3.33 guesses, branch 48
+	"align": 25,  // 4.00 guesses, branch 127
+	"alike": 1,   // 3.17 guesses, branch 49
+	"alive": 4,   // 3.83 guesses, branch 49
+	"allay": 10,  // 3.67 guesses, branch 134
+	"alley": 30,  // 4.83 guesses, branch 48
+	"allot": 3,   // 3.17 guesses, branch 134
+	"allow": 58,  // 4.67 guesses, branch 134
+	"alloy": 62,  // 4.83 guesses, branch 134
+	"aloft": 3,   // 3.00 guesses, branch 134
+	"alone": 0,   // 3.17 guesses, branch 49
+	"along": 19,  // 3.83 guesses, branch 127
+	"aloof": 50,  // 4.50 guesses, branch 134
+	"aloud": 6,   // 3.33 guesses, branch 134
+	"alpha": 49,  // 4.50 guesses, branch 134
+	"altar": 0,   // 3.00 guesses, branch 65
+	"alter": 0,   // 3.00 guesses, branch 50
+	"amass": 28,  // 4.67 guesses, branch 49
+	"amaze": 60,  // 5.00 guesses, branch 83
+	"amber": 41,  // 4.83 guesses, branch 61
+	"amble": 5,   // 3.83 guesses, branch 49
+	"amend": 4,   // 3.50 guesses, branch 83
+	"amiss": 42,  // 4.33 guesses, branch 134
+	"amity": 36,  // 4.17 guesses, branch 134
+	"among": 32,  // 4.00 guesses, branch 136
+	"ample": 16,  // 4.33 guesses, branch 49
+	"amply": 19,  // 3.67 guesses, branch 134
+	"amuse": 7,   // 4.00 guesses, branch 49
+	"angel": 14,  // 4.33 guesses, branch 48
+	"anger": 3,   // 3.67 guesses, branch 61
+	"angle": 42,  // 5.00 guesses, branch 49
+	"angry": 28,  // 4.00 guesses, branch 136
+	"angst": 2,   // 3.33 guesses, branch 53
+	"anime": 2,   // 3.33 guesses, branch 83
+	"ankle": 87,  // 6.33 guesses, branch 49
+	"annex": 16,  // 4.17 guesses, branch 61
+	"annoy": 27,  // 4.00 guesses, branch 136
+	"annul": 32,  // 4.17 guesses, branch 127
+	"anode": 5,   // 3.67 guesses, branch 83
+	"antic": 12,  // 4.17 guesses, branch 51
+	"anvil": 35,  // 4.17 guesses, branch 127
+	"aorta": 2,   // 3.50 guesses, branch 65
+	"apart": 3,   // 3.83 guesses, branch 47
+	"aphid": 49,  // 4.33 guesses, branch 136
+	"aping": 96,  // 6.50 guesses, branch 136
+	"apnea": 21,  // 4.50 guesses, branch 61
+	"apple": 52,  // 5.17 guesses, branch 49
+	"apply": 69,  // 4.83 guesses, branch 134
+	"apron": 18,  // 3.83 guesses, branch 136
+	"aptly": 15,  // 3.67 guesses, branch 134
+	"arbor": 11,  // 3.67 guesses, branch 136
+	"ardor": 14,  // 3.83 guesses, branch 136
+	"arena": 2,   // 3.50 guesses, branch 83
+	"argue": 2,   // 3.33 guesses, branch 83
+	"arise": 0,   // 3.00 guesses, branch 16
+	"armor": 44,  // 4.50 guesses, branch 136
+	"aroma": 27,  // 4.17 guesses, branch 136
+	"arose": 0,   // 3.50 guesses, branch 31
+	"array": 30,  // 4.33 guesses, branch 136
+	"arrow": 16,  // 3.83 guesses, branch 136
+	"arson": 0,   // 3.00 guesses, branch 43
+	"artsy": 1,   // 3.33 guesses, branch 47
+	"ascot": 1,   // 3.17 guesses, branch 43
+	"ashen": 1,   // 3.33 guesses, branch 48
+	"aside": 1,   // 3.33 guesses, branch 49
+	"askew": 19,  // 4.33 guesses, branch 48
+	"assay": 37,  // 4.33 guesses, branch 134
+	"asset": 2,   // 3.67 guesses, branch 47
+	"atoll": 7,   // 3.50 guesses, branch 134
+	"atone": 2,   // 3.83 guesses, branch 31
+	"attic": 7,   // 4.00 guesses, branch 51
+	"audio": 6,   // 3.33 guesses, branch 136
+	"audit": 10,  // 3.50 guesses, branch 134
+	"augur": 22,  // 3.83 guesses, branch 136
+	"aunty": 10,  // 4.00 guesses, branch 53
+	"avail": 11,  // 4.00 guesses, branch 67
+	"avert": 32,  // 4.83 guesses, branch 50
+	"avian": 57,  // 4.67 guesses, branch 136
+	"avoid": 18,  // 3.67 guesses, branch 136
+	"await": 22,  // 4.50 guesses, branch 49
+	"awake": 43,  // 4.67 guesses, branch 83
+	"award": 19,  // 4.00 guesses, branch 102
+	"aware": 1,   // 3.17 guesses, branch 83
+	"awash": 9,   // 4.00 guesses, branch 49
+	"awful": 71,  // 4.83 guesses, branch 134
+	"awoke": 82,  // 5.67 guesses, branch 83
+	"axial": 41,  // 4.33 guesses, branch 134
+	"axiom": 43,  // 4.17 guesses, branch 136
+	"axion": 85,  // 5.50 guesses, branch 136
+	"azure": 51,  // 4.83 guesses, branch 83
+	"bacon": 50,  // 4.00 guesses, branch 284
+	"badge": 9,   // 4.00 guesses, branch 49
+	"badly": 41,  // 4.17 guesses, branch 134
+	"bagel": 86,  // 5.00 guesses, branch 435
+	"baggy": 93,  // 5.33 guesses, branch 435
+	"baker": 97,  // 6.17 guesses, branch 435
+	"baler": 40,  // 3.83 guesses, branch 435
+	"balmy": 64,  // 4.00 guesses, branch 435
+	"banal": 46,  // 3.83 guesses, branch 435
+	"banjo": 86,  // 5.00 guesses, branch 284
+	"barge": 45,  // 3.83 guesses, branch 435
+	"baron": 21,  // 3.50 guesses, branch 284
+	"basal": 37,  // 3.67 guesses, branch 435
+	"basic": 8,   // 3.83 guesses, branch 60
+	"basil": 14,  // 3.67 guesses, branch 134
+	"basin": 3,   // 3.00 guesses, branch 127
+	"basis": 70,  // 5.00 guesses, branch 134
+	"baste": 15,  // 3.17 guesses, branch 435
+	"batch": 47,  // 3.67 guesses, branch 435
+	"bathe": 46,  // 3.83 guesses, branch 435
+	"baton": 39,  // 3.83 guesses, branch 284
+	"batty": 44,  // 3.67 guesses, branch 435
+	"bawdy": 69,  // 4.67 guesses, branch 136
+	"bayou": 56,  // 4.50 guesses, branch 136
+	"beach": 42,  // 3.67 guesses, branch 435
+	"beady": 3,   // 3.33 guesses, branch 83
+	"beard": 2,   // 3.17 guesses, branch 83
+	"beast": 15,  // 3.17 guesses, branch 435
+	"beech": 34,  // 4.00 guesses, branch 197
+	"beefy": 73,  // 4.83 guesses, branch 197
+	"befit": 52,  // 4.50 guesses, branch 127
+	"began": 57,  // 4.00 guesses, branch 435
+	"begat": 47,  // 3.83 guesses, branch 435
+	"beget": 8,   // 3.33 guesses, branch 190
+	"begin": 28,  // 3.83 guesses, branch 165
+	"begun": 39,  // 4.00 guesses, branch 165
+	"being": 56,  // 4.17 guesses, branch 265
+	"belch": 19,  // 3.50 guesses, branch 197
+	"belie": 35,  // 4.50 guesses, branch 104
+	"belle": 36,  // 4.17 guesses, branch 197
+	"belly": 37,  // 4.00 guesses, branch 197
+	"below": 57,  // 4.17 guesses, branch 282
+	"bench": 34,  // 3.83 guesses, branch 197
+	"beret": 8,   // 3.50 guesses, branch 190
+	"berry": 24,  // 3.83 guesses, branch 197
+	"berth": 34,  // 4.00 guesses, branch 197
+	"beset": 6,   // 3.33 guesses, branch 190
+	"betel": 9,   // 3.50 guesses, branch 190
+	"bevel": 26,  // 3.83 guesses, branch 190
+	"bezel": 71,  // 4.83 guesses, branch 190
+	"bible": 68,  // 4.50 guesses, branch 265
+	"bicep": 41,  // 3.83 guesses, branch 265
+	"biddy": 59,  // 4.00 guesses, branch 263
+	"bigot": 36,  // 3.67 guesses, branch 263
+	"bilge": 62,  // 4.33 guesses, branch 265
+	"billy": 65,  // 4.33 guesses, branch 265
+	"binge": 48,  // 4.00 guesses, branch 265
+	"bingo": 44,  // 3.83 guesses, branch 246
+	"biome": 26,  // 3.83 guesses, branch 165
+	"birch": 21,  // 3.33 guesses, branch 265
+	"birth": 40,  // 3.83 guesses, branch 265
+	"bison": 18,  // 3.33 guesses, branch 246
+	"bitty": 34,  // 3.67 guesses, branch 265
+	"black": 65,  // 4.00 guesses, branch 435
+	"blade": 8,   // 4.00 guesses, branch 54
+	"blame": 79,  // 4.67 guesses, branch 435
+	"bland": 22,  // 4.17 guesses, branch 67
+	"blank": 56,  // 3.83 guesses, branch 435
+	"blare": 63,  // 4.33 guesses, branch 435
+	"blast": 13,  // 3.00 guesses, branch 435
+	"blaze": 95,  // 5.83 guesses, branch 435
+	"bleak": 64,  // 4.17 guesses, branch 435
+	"bleat": 27,  // 3.50 guesses, branch 435
+	"bleed": 3,   // 3.17 guesses, branch 127
+	"bleep": 31,  // 4.00 guesses, branch 190
+	"blend": 15,  // 3.67 guesses, branch 123
+	"bless": 81,  // 5.17 guesses, branch 197
+	"blimp": 41,  // 3.67 guesses, branch 265
+	"blind": 27,  // 3.50 guesses, branch 246
+	"blink": 88,  // 5.17 guesses, branch 265
+	"bliss": 69,  // 4.50 guesses, branch 265
+	"blitz": 32,  // 3.50 guesses, branch 265
+	"bloat": 29,  // 3.67 guesses, branch 284
+	"block": 43,  // 3.67 guesses, branch 282
+	"bloke": 70,  // 4.50 guesses, branch 282
+	"blond": 35,  // 3.67 guesses, branch 246
+	"blood": 51,  // 4.00 guesses, branch 263
+	"bloom": 88,  // 5.17 guesses, branch 282
+	"blown": 49,  // 3.83 guesses, branch 282
+	"bluer": 22,  // 4.00 guesses, branch 122
+	"bluff": 62,  // 4.00 guesses, branch 263
+	"blunt": 20,  // 3.50 guesses, branch 188
+	"blurb": 44,  // 4.00 guesses, branch 188
+	"blurt": 17,  // 3.50 guesses, branch 188
+	"blush": 31,  // 3.50 guesses, branch 263
+	"board": 28,  // 4.17 guesses, branch 102
+	"boast": 45,  // 4.00 guesses, branch 284
+	"bobby": 68,  // 4.17 guesses, branch 282
+	"boney": 62,  // 4.33 guesses, branch 282
+	"bongo": 59,  // 4.17 guesses, branch 246
+	"bonus": 80,  // 4.83 guesses, branch 246
+	"booby": 64,  // 4.17 guesses, branch 282
+	"boost": 49,  // 4.00 guesses, branch 282
+	"booth": 52,  // 4.00 guesses, branch 282
+	"booty": 71,  // 4.50 guesses, branch 282
+	"booze": 84,  // 5.00 guesses, branch 282
+	"boozy": 100, // 7.00 guesses, branch 282
+	"borax": 81,  // 4.83 guesses, branch 284
+	"borne": 33,  // 3.83 guesses, branch 282
+	"bosom": 39,  // 3.67 guesses, branch 282
+	"bossy": 66,  // 4.33 guesses, branch 282
+	"botch": 40,  // 3.67 guesses, branch 282
+	"bough": 65,  // 4.17 guesses, branch 263
+	"boule": 21,  // 4.00 guesses, branch 104
+	"bound": 61,  // 4.17 guesses, branch 246
+	"bowel": 80,  // 4.83 guesses, branch 282
+	"boxer": 99,  // 7.00 guesses, branch 282
+	"brace": 27,  // 3.50 guesses, branch 435
+	"braid": 10,  // 3.67 guesses, branch 102
+	"brain": 2,   // 3.00 guesses, branch 102
+	"brake": 82,  // 4.83 guesses, branch 435
+	"brand": 11,  // 3.67 guesses, branch 102
+	"brash": 27,  // 3.33 guesses, branch 435
+	"brass": 98,  // 6.67 guesses, branch 435
+	"brave": 92,  // 5.50 guesses, branch 435
+	"bravo": 76,  // 4.67 guesses, branch 284
+	"brawl": 82,  // 4.67 guesses, branch 435
+	"brawn": 46,  // 3.67 guesses, branch 435
+	"bread": 9,   // 3.83 guesses, branch 83
+	"break": 39,  // 3.67 guesses, branch 435
+	"breed": 19,  // 3.83 guesses, branch 165
+	"briar": 7,   // 3.50 guesses, branch 136
+	"bribe": 72,  // 4.67 guesses, branch 265
+	"brick": 12,  // 3.00 guesses, branch 265
+	"bride": 17,  // 3.67 guesses, branch 165
+	"brief": 29,  // 3.67 guesses, branch 265
+	"brine": 24,  // 3.67 guesses, branch 265
+	"bring": 15,  // 3.17 guesses, branch 265
+	"brink": 45,  // 3.83 guesses, branch 265
+	"briny": 80,  // 4.83 guesses, branch 265
+	"brisk": 21,  // 3.33 guesses, branch 265
+	"broad": 20,  // 3.83 guesses, branch 136
+	"broil": 8,   // 3.50 guesses, branch 114
+	"broke": 46,  // 4.00 guesses, branch 282
+	"brood": 10,  // 3.17 guesses, branch 221
+	"brook": 60,  // 4.17 guesses, branch 282
+	"broom": 87,  // 5.17 guesses, branch 282
+	"broth": 19,  // 3.33 guesses, branch 282
+	"brown": 54,  // 4.00 guesses, branch 282
+	"brunt": 13,  // 3.33 guesses, branch 188
+	"brush": 10,  // 3.17 guesses, branch 188
+	"brute": 26,  // 4.17 guesses, branch 109
+	"buddy": 90,  // 5.17 guesses, branch 263
+	"budge": 41,  // 4.00 guesses, branch 165
+	"buggy": 92,  // 5.33 guesses, branch 263
+	"bugle": 9,   // 3.50 guesses, branch 104
+	"build": 17,  // 3.17 guesses, branch 263
+	"built": 14,  // 3.17 guesses, branch 263
+	"bulge": 25,  // 4.00 guesses, branch 104
+	"bulky": 66,  // 4.17 guesses, branch 263
+	"bully": 61,  // 4.17 guesses, branch 263
+	"bunch": 30,  // 3.50 guesses, branch 221
+	"bunny": 68,  // 4.33 guesses, branch 246
+	"burly": 42,  // 4.00 guesses, branch 188
+	"burnt": 24,  // 3.67 guesses, branch 188
+	"burst": 8,   // 3.17 guesses, branch 188
+	"bused": 16,  // 3.67 guesses, branch 127
+	"bushy": 58,  // 4.00 guesses, branch 263
+	"butch": 18,  // 3.33 guesses, branch 188
+	"butte": 43,  // 4.50 guesses, branch 102
+	"buxom": 69,  // 4.17 guesses, branch 263
+	"buyer": 79,  // 5.17 guesses, branch 165
+	"bylaw": 76,  // 4.33 guesses, branch 435
+	"cabal": 83,  // 4.83 guesses, branch 435
+	"cabby": 77,  // 4.33 guesses, branch 435
+	"cabin": 47,  // 4.33 guesses, branch 136
+	"cable": 16,  // 3.17 guesses, branch 435
+	"cacao": 50,  // 4.17 guesses, branch 284
+	"cache": 79,  // 4.67 guesses, branch 435
+	"cacti": 3,   // 3.50 guesses, branch 60
+	"caddy": 35,  // 4.00 guesses, branch 136
+	"cadet": 1,   // 3.67 guesses, branch 25
+	"cagey": 75,  // 4.50 guesses, branch 435
+	"cairn": 17,  // 3.83 guesses, branch 136
+	"camel": 53,  // 4.00 guesses, branch 435
+	"cameo": 25,  // 4.50 guesses, branch 61
+	"canal": 81,  // 4.83 guesses, branch 435
+	"candy": 25,  // 3.83 guesses, branch 136
+	"canny": 77,  // 4.50 guesses, branch 435
+	"canoe": 43,  // 4.33 guesses, branch 188
+	"canon": 67,  // 4.50 guesses, branch 284
+	"caper": 64,  // 4.33 guesses, branch 435
+	"caput": 7,   // 3.83 guesses, branch 51
+	"carat": 55,  // 4.17 guesses, branch 435
+	"cargo": 37,  // 3.83 guesses, branch 284
+	"carol": 24,  // 3.67 guesses, branch 284
+	"carry": 87,  // 5.17 guesses, branch 435
+	"carve": 95,  // 6.00 guesses, branch 435
+	"caste": 32,  // 3.67 guesses, branch 435
+	"catch": 77,  // 4.50 guesses, branch 435
+	"cater": 29,  // 3.67 guesses, branch 435
+	"catty": 69,  // 4.33 guesses, branch 435
+	"caulk": 20,  // 4.17 guesses, branch 58
+	"cause": 12,  // 4.50 guesses, branch 32
+	"cavil": 14,  // 4.00 guesses, branch 60
+	"cease": 20,  // 3.50 guesses, branch 435
+	"cedar": 1,   // 3.17 guesses, branch 83
+	"cello": 31,  // 4.00 guesses, branch 197
+	"chafe": 87,  // 5.00 guesses, branch 435
+	"chaff": 92,  // 5.17 guesses, branch 435
+	"chain": 11,  // 3.67 guesses, branch 102
+	"chair": 9,   // 3.67 guesses, branch 102
+	"chalk": 63,  // 4.00 guesses, branch 435
+	"champ": 81,  // 4.50 guesses, branch 435
+	"chant": 81,  // 4.67 guesses, branch 435
+	"chaos": 85,  // 5.17 guesses, branch 284
+	"chard": 22,  // 4.00 guesses, branch 102
+	"charm": 87,  // 5.00 guesses, branch 435
+	"chart": 68,  // 4.33 guesses, branch 435
+	"chase": 60,  // 4.17 guesses, branch 435
+	"chasm": 40,  // 3.50 guesses, branch 435
+	"cheap": 69,  // 4.33 guesses, branch 435
+	"cheat": 44,  // 3.83 guesses, branch 435
+	"check": 52,  // 4.17 guesses, branch 197
+	"cheek": 51,  // 4.33 guesses, branch 190
+	"cheer": 41,  // 4.33 guesses, branch 190
+	"chess": 87,  // 5.50 guesses, branch 197
+	"chest": 20,  // 3.67 guesses, branch 197
+	"chick": 76,  // 4.50 guesses, branch 265
+	"chide": 43,  // 4.17 guesses, branch 165
+	"chief": 25,  // 3.50 guesses, branch 265
+	"child": 4,   // 3.17 guesses, branch 99
+	"chili": 64,  // 4.33 guesses, branch 265
+	"chill": 83,  // 5.00 guesses, branch 265
+	"chime": 69,  // 4.50 guesses, branch 265
+	"china": 37,  // 4.17 guesses, branch 136
+	"chirp": 84,  // 5.00 guesses, branch 265
+	"chock": 52,  // 3.83 guesses, branch 282
+	"choir": 25,  // 3.67 guesses, branch 221
+	"choke": 65,  // 4.33 guesses, branch 282
+	"chord": 22,  // 3.50 guesses, branch 221
+	"chore": 41,  // 4.00 guesses, branch 282
+	"chose": 36,  // 3.83 guesses, branch 282
+	"chuck": 49,  // 3.83 guesses, branch 221
+	"chump": 40,  // 3.67 guesses, branch 221
+	"chunk": 31,  // 3.50 guesses, branch 221
+	"churn": 16,  // 3.33 guesses, branch 221
+	"chute": 30,  // 4.17 guesses, branch 109
+	"cider": 21,  // 3.83 guesses, branch 165
+	"cigar": 14,  // 3.67 guesses, branch 136
+	"cinch": 22,  // 3.33 guesses, branch 265
+	"circa": 31,  // 4.17 guesses, branch 136
+	"civic": 27,  // 3.50 guesses, branch 221
+	"civil": 23,  // 3.83 guesses, branch 114
+	"clack": 86,  // 4.83 guesses, branch 435
+	"claim": 9,   // 3.83 guesses, branch 67
+	"clamp": 55,  // 3.83 guesses, branch 435
+	"clang": 45,  // 3.67 guesses, branch 435
+	"clank": 67,  // 4.17 guesses, branch 435
+	"clash": 50,  // 3.83 guesses, branch 435
+	"clasp": 51,  // 3.83 guesses, branch 435
+	"class": 97,  // 6.17 guesses, branch 435
+	"clean": 25,  // 3.50 guesses, branch 435
+	"clear": 45,  // 4.00 guesses, branch 435
+	"cleat": 31,  // 3.67 guesses, branch 435
+	"cleft": 11,  // 3.33 guesses, branch 197
+	"clerk": 20,  // 3.67 guesses, branch 197
+	"click": 46,  // 3.83 guesses, branch 265
+	"cliff": 64,  // 4.17 guesses, branch 265
+	"climb": 68,  // 4.33 guesses, branch 265
+	"cling": 28,  // 3.50 guesses, branch 265
+	"clink": 53,  // 4.00 guesses, branch 265
+	"cloak": 19,  // 3.33 guesses, branch 284
+	"clock": 68,  // 4.33 guesses, branch 282
+	"clone": 42,  // 4.00 guesses, branch 282
+	"close": 19,  // 3.50 guesses, branch 282
+	"cloth": 57,  // 4.17 guesses, branch 282
+	"cloud": 42,  // 4.33 guesses, branch 99
+	"clout": 7,   // 3.17 guesses, branch 188
+	"clove": 92,  // 5.67 guesses, branch 282
+	"clown": 67,  // 4.33 guesses, branch 282
+	"cluck": 32,  // 3.67 guesses, branch 188
+	"clued": 5,   // 3.83 guesses, branch 43
+	"clump": 49,  // 4.00 guesses, branch 188
+	"clung": 16,  // 3.33 guesses, branch 188
+	"coach": 64,  // 4.33 guesses, branch 284
+	"coast": 43,  // 4.00 guesses, branch 284
+	"cobra": 29,  // 3.67 guesses, branch 284
+	"cocoa": 72,  // 4.67 guesses, branch 284
+	"colon": 55,  // 4.17 guesses, branch 282
+	"color": 71,  // 4.67 guesses, branch 282
+	"comet": 29,  // 3.67 guesses, branch 282
+	"comfy": 85,  // 4.83 guesses, branch 282
+	"comic": 24,  // 3.50 guesses, branch 221
+	"comma": 84,  // 5.00 guesses, branch 284
+	"conch": 54,  // 4.00 guesses, branch 282
+	"condo": 45,  // 4.00 guesses, branch 221
+	"conic": 21,  // 3.50 guesses, branch 221
+	"copse": 53,  // 4.17 guesses, branch 282
+	"coral": 40,  // 4.00 guesses, branch 284
+	"corer": 70,  // 4.83 guesses, branch 282
+	"corny": 75,  // 4.67 guesses, branch 282
+	"couch": 70,  // 4.50 guesses, branch 221
+	"cough": 81,  // 4.83 guesses, branch 221
+	"could": 14,  // 3.67 guesses, branch 99
+	"count": 18,  // 3.50 guesses, branch 188
+	"coupe": 25,  // 3.83 guesses, branch 165
+	"court": 10,  // 3.33 guesses, branch 188
+	"coven": 95,  // 6.00 guesses, branch 282
+	"cover": 98,  // 6.50 guesses, branch 282
+	"covet": 74,  // 4.67 guesses, branch 282
+	"covey": 97,  // 6.33 guesses, branch 282
+	"cower": 94,  // 6.00 guesses, branch 282
+	"coyly": 82,  // 4.83 guesses, branch 282
+	"crack": 82,  // 4.67 guesses, branch 435
+	"craft": 50,  // 3.83 guesses, branch 435
+	"cramp": 66,  // 4.17 guesses, branch 435
+	"crane": 68,  // 4.50 guesses, branch 435
+	"crank": 71,  // 4.33 guesses, branch 435
+	"crash": 48,  // 3.83 guesses, branch 435
+	"crass": 96,  // 6.17 guesses, branch 435
+	"crate": 22,  // 3.50 guesses, branch 435
+	"crave": 99,  // 6.83 guesses, branch 435
+	"crawl": 80,  // 4.67 guesses, branch 435
+	"craze": 100, // 7.00 guesses, branch 435
+	"crazy": 93,  // 5.50 guesses, branch 435
+	"creak": 36,  // 3.67 guesses, branch 435
+	"cream": 34,  // 3.67 guesses, branch 435
+	"credo": 7,   // 3.33 guesses, branch 165
+	"creed": 38,  // 4.33 guesses, branch 165
+	"creek": 36,  // 4.17 guesses, branch 190
+	"creep": 26,  // 4.00 guesses, branch 190
+	"creme": 15,  // 3.67 guesses, branch 197
+	"crepe": 20,  // 3.83 guesses, branch 197
+	"crept": 9,   // 3.33 guesses, branch 197
+	"cress": 90,  // 5.83 guesses, branch 197
+	"crest": 3,   // 3.00 guesses, branch 197
+	"crick": 59,  // 4.17 guesses, branch 265
+	"cried": 4,   // 3.17 guesses, branch 165
+	"crier": 14,  // 3.50 guesses, branch 265
+	"crime": 41,  // 4.00 guesses, branch 265
+	"crimp": 21,  // 3.33 guesses, branch 265
+	"crisp": 39,  // 3.83 guesses, branch 265
+	"croak": 39,  // 3.83 guesses, branch 284
+	"crock": 53,  // 4.00 guesses, branch 282
+	"crone": 17,  // 3.50 guesses, branch 282
+	"crony": 63,  // 4.33 guesses, branch 282
+	"crook": 33,  // 3.67 guesses, branch 282
+	"cross": 91,  // 5.67 guesses, branch 282
+	"croup": 81,  // 5.00 guesses, branch 221
+	"crowd": 86,  // 5.17 guesses, branch 221
+	"crown": 35,  // 3.67 guesses, branch 282
+	"crude": 17,  // 3.67 guesses, branch 165
+	"cruel": 8,   // 3.67 guesses, branch 109
+	"crumb": 35,  // 3.67 guesses, branch 221
+	"crump": 57,  // 4.17 guesses, branch 221
+	"crush": 32,  // 3.83 guesses, branch 188
+	"crust": 21,  // 3.67 guesses, branch 188
+	"crypt": 25,  // 3.67 guesses, branch 190
+	"cubic": 59,  // 4.17 guesses, branch 221
+	"cumin": 19,  // 3.33 guesses, branch 221
+	"curio": 13,  // 3.33 guesses, branch 221
+	"curly": 47,  // 4.17 guesses, branch 188
+	"curry": 49,  // 4.17 guesses, branch 221
+	"curse": 6,   // 3.67 guesses, branch 91
+	"curve": 42,  // 4.17 guesses, branch 165
+	"curvy": 87,  // 5.17 guesses, branch 221
+	"cutie": 3,   // 3.67 guesses, branch 52
+	"cyber": 72,  // 4.83 guesses, branch 190
+	"cycle": 67,  // 4.67 guesses, branch 197
+	"cynic": 48,  // 4.00 guesses, branch 221
+	"daddy": 72,  // 4.83 guesses, branch 136
+	"daily": 15,  // 3.67 guesses, branch 134
+	"dairy": 19,  // 3.83 guesses, branch 136
+	"daisy": 36,  // 4.17 guesses, branch 134
+	"dally": 60,  // 4.67 guesses, branch 134
+	"dance": 8,   // 4.00 guesses, branch 54
+	"dandy": 64,  // 4.67 guesses, branch 136
+	"datum": 48,  // 4.33 guesses, branch 134
+	"daunt": 4,   // 3.67 guesses, branch 53
+	"dealt": 1,   // 3.17 guesses, branch 54
+	"death": 7,   // 4.00 guesses, branch 54
+	"debar": 4,   // 3.50 guesses, branch 83
+	"debit": 26,  // 4.00 guesses, branch 127
+	"debug": 57,  // 4.33 guesses, branch 165
+	"debut": 72,  // 5.00 guesses, branch 127
+	"decal": 7,   // 4.00 guesses, branch 54
+	"decay": 84,  // 5.83 guesses, branch 83
+	"decor": 10,  // 3.50 guesses, branch 165
+	"decoy": 26,  // 3.83 guesses, branch 165
+	"decry": 13,  // 3.50 guesses, branch 165
+	"defer": 33,  // 4.17 guesses, branch 165
+	"deign": 14,  // 3.50 guesses, branch 165
+	"deity": 24,  // 4.00 guesses, branch 127
+	"delay": 12,  // 4.17 guesses, branch 54
+	"delta": 1,   // 3.17 guesses, branch 54
+	"delve": 25,  // 4.17 guesses, branch 104
+	"demon": 33,  // 4.00 guesses, branch 165
+	"demur": 63,  // 4.67 guesses, branch 165
+	"denim": 50,  // 4.33 guesses, branch 165
+	"dense": 10,  // 3.83 guesses, branch 104
+	"depot": 25,  // 4.00 guesses, branch 127
+	"depth": 29,  // 4.00 guesses, branch 127
+	"derby": 26,  // 3.83 guesses, branch 165
+	"deter": 27,  // 4.33 guesses, branch 122
+	"detox": 37,  // 4.17 guesses, branch 127
+	"deuce": 10,  // 3.50 guesses, branch 165
+	"devil": 10,  // 3.50 guesses, branch 127
+	"diary": 14,  // 3.83 guesses, branch 102
+	"dicey": 33,  // 4.00 guesses, branch 165
+	"digit": 43,  // 3.83 guesses, branch 263
+	"dilly": 57,  // 4.17 guesses, branch 263
+	"dimly": 67,  // 4.33 guesses, branch 263
+	"diner": 26,  // 4.00 guesses, branch 165
+	"dingo": 80,  // 4.83 guesses, branch 246
+	"dingy": 46,  // 3.83 guesses, branch 246
+	"diode": 55,  // 4.50 guesses, branch 165
+	"dirge": 7,   // 3.33 guesses, branch 165
+	"dirty": 26,  // 4.17 guesses, branch 90
+	"disco": 5,   // 3.67 guesses, branch 55
+	"ditch": 18,  // 3.83 guesses, branch 86
+	"ditto": 22,  // 3.50 guesses, branch 263
+	"ditty": 56,  // 4.17 guesses, branch 263
+	"diver": 92,  // 6.00 guesses, branch 165
+	"dizzy": 98,  // 6.33 guesses, branch 263
+	"dodge": 66,  // 4.67 guesses, branch 165
+	"dodgy": 50,  // 3.83 guesses, branch 263
+	"dogma": 14,  // 3.50 guesses, branch 136
+	"doing": 58,  // 4.17 guesses, branch 246
+	"dolly": 64,  // 4.33 guesses, branch 263
+	"donor": 31,  // 3.83 guesses, branch 221
+	"donut": 4,   // 3.17 guesses, branch 113
+	"dopey": 51,  // 4.33 guesses, branch 165
+	"doubt": 46,  // 3.83 guesses, branch 263
+	"dough": 64,  // 4.17 guesses, branch 263
+	"dowdy": 84,  // 4.83 guesses, branch 263
+	"dowel": 43,  // 4.33 guesses, branch 127
+	"downy": 55,  // 4.00 guesses, branch 246
+	"dowry": 40,  // 3.83 guesses, branch 221
+	"dozen": 88,  // 5.50 guesses, branch 165
+	"draft": 10,  // 4.00 guesses, branch 54
+	"drain": 8,   // 3.67 guesses, branch 102
+	"drake": 6,   // 3.67 guesses, branch 83
+	"drama": 31,  // 4.33 guesses, branch 102
+	"drank": 30,  // 4.17 guesses, branch 102
+	"drape": 5,   // 3.67 guesses, branch 83
+	"drawl": 76,  // 5.50 guesses, branch 67
+	"drawn": 12,  // 3.67 guesses, branch 102
+	"dread": 8,   // 3.83 guesses, branch 83
+	"dream": 8,   // 3.83 guesses, branch 83
+	"dress": 35,  // 5.00 guesses, branch 38
+	"dried": 5,   // 3.17 guesses, branch 165
+	"drier": 12,  // 3.67 guesses, branch 165
+	"drift": 10,  // 3.67 guesses, branch 86
+	"drill": 14,  // 3.83 guesses, branch 99
+	"drink": 46,  // 4.00 guesses, branch 221
+	"drive": 32,  // 4.00 guesses, branch 165
+	"droit": 16,  // 4.00 guesses, branch 86
+	"droll": 33,  // 4.33 guesses, branch 99
+	"drone": 70,  // 5.00 guesses, branch 165
+	"drool": 80,  // 5.50 guesses, branch 99
+	"droop": 94,  // 6.00 guesses, branch 221
+	"dross": 13,  // 4.17 guesses, branch 51
+	"drove": 95,  // 6.33 guesses, branch 165
+	"drown": 78,  // 4.83 guesses, branch 221
+	"druid": 22,  // 3.50 guesses, branch 221
+	"drunk": 33,  // 3.67 guesses, branch 221
+	"dryer": 33,  // 4.17 guesses, branch 165
+	"dryly": 19,  // 3.83 guesses, branch 99
+	"duchy": 23,  // 3.33 guesses, branch 221
+	"dully": 89,  // 5.33 guesses, branch 263
+	"dummy": 73,  // 4.33 guesses, branch 263
+	"dumpy": 73,  // 4.33 guesses, branch 263
+	"dunce": 43,  // 4.17 guesses, branch 165
+	"dusky": 77,  // 4.50 guesses, branch 263
+	"dusty": 66,  // 4.33 guesses, branch 263
+	"dutch": 57,  // 4.67 guesses, branch 86
+	"duvet": 39,  // 4.17 guesses, branch 127
+	"dwarf": 51,  // 4.50 guesses, branch 102
+	"dwell": 21,  // 3.83 guesses, branch 127
+	"dwelt": 6,   // 3.33 guesses, branch 127
+	"dying": 54,  // 4.00 guesses, branch 246
+	"eager": 70,  // 4.67 guesses, branch 435
+	"eagle": 84,  // 5.17 guesses, branch 435
+	"early": 11,  // 3.17 guesses, branch 435
+	"earth": 38,  // 3.83 guesses, branch 435
+	"easel": 8,   // 3.17 guesses, branch 435
+	"eaten": 19,  // 3.50 guesses, branch 435
+	"eater": 44,  // 4.17 guesses, branch 435
+	"ebony": 40,  // 3.83 guesses, branch 282
+	"eclat": 8,   // 3.00 guesses, branch 435
+	"edict": 2,   // 3.17 guesses, branch 72
+	"edify": 45,  // 4.17 guesses, branch 165
+	"eerie": 9,   // 3.83 guesses, branch 165
+	"egret": 24,  // 4.00 guesses, branch 190
+	"eight": 37,  // 3.83 guesses, branch 265
+	"eject": 26,  // 3.83 guesses, branch 197
+	"eking": 70,  // 4.50 guesses, branch 265
+	"elate": 68,  // 4.67 guesses, branch 435
+	"elbow": 42,  // 3.83 guesses, branch 282
+	"elder": 10,  // 3.83 guesses, branch 122
+	"elect": 13,  // 3.67 guesses, branch 197
+	"elegy": 12,  // 3.50 guesses, branch 197
+	"elfin": 18,  // 3.83 guesses, branch 123
+	"elide": 4,   // 3.50 guesses, branch 104
+	"elite": 30,  // 4.00 guesses, branch 265
+	"elope": 15,  // 3.50 guesses, branch 282
+	"elude": 21,  // 4.17 guesses, branch 104
+	"email": 3,   // 3.67 guesses, branch 49
+	"embed": 75,  // 5.00 guesses, branch 165
+	"ember": 45,  // 4.33 guesses, branch 190
+	"emcee": 31,  // 4.17 guesses, branch 190
+	"empty": 33,  // 3.83 guesses, branch 197
+	"enact": 13,  // 3.17 guesses, branch 435
+	"endow": 35,  // 4.00 guesses, branch 165
+	"enema": 46,  // 4.00 guesses, branch 435
+	"enemy": 24,  // 3.83 guesses, branch 197
+	"enjoy": 51,  // 4.00 guesses, branch 282
+	"ennui": 29,  // 4.00 guesses, branch 165
+	"ensue": 15,  // 4.00 guesses, branch 109
+	"enter": 43,  // 4.50 guesses, branch 190
+	"entry": 23,  // 3.83 guesses, branch 197
+	"envoy": 64,  // 4.33 guesses, branch 282
+	"epoch": 32,  // 3.67 guesses, branch 282
+	"epoxy": 73,  // 4.50 guesses, branch 282
+	"equal": 18,  // 4.33 guesses, branch 49
+	"equip": 40,  // 4.00 guesses, branch 165
+	"erase": 37,  // 4.00 guesses, branch 435
+	"erect": 5,   // 3.33 guesses, branch 197
+	"erode": 6,   // 3.50 guesses, branch 165
+	"error": 37,  // 4.17 guesses, branch 282
+	"erupt": 9,   // 3.67 guesses, branch 109
+	"essay": 57,  // 4.17 guesses, branch 435
+	"ester": 3,   // 3.17 guesses, branch 190
+	"ether": 38,  // 4.33 guesses, branch 190
+	"ethic": 9,   // 3.83 guesses, branch 72
+	"ethos": 57,  // 4.33 guesses, branch 282
+	"etude": 7,   // 3.67 guesses, branch 102
+	"evade": 4,   // 3.50 guesses, branch 83
+	"event": 23,  // 3.83 guesses, branch 197
+	"every": 39,  // 4.17 guesses, branch 197
+	"evict": 46,  // 4.00 guesses, branch 265
+	"evoke": 85,  // 5.17 guesses, branch 282
+	"exact": 63,  // 4.17 guesses, branch 435
+	"exalt": 17,  // 3.17 guesses, branch 435
+	"excel": 33,  // 4.00 guesses, branch 190
+	"exert": 36,  // 4.17 guesses, branch 197
+	"exile": 73,  // 4.83 guesses, branch 265
+	"exist": 37,  // 3.83 guesses, branch 265
+	"expel": 65,  // 4.67 guesses, branch 190
+	"extol": 46,  // 4.00 guesses, branch 282
+	"extra": 43,  // 3.83 guesses, branch 435
+	"exult": 21,  // 3.83 guesses, branch 127
+	"eying": 90,  // 5.50 guesses, branch 265
+	"fable": 70,  // 4.33 guesses, branch 435
+	"facet": 39,  // 3.67 guesses, branch 435
+	"faint": 10,  // 4.00 guesses, branch 53
+	"fairy": 44,  // 4.33 guesses, branch 136
+	"faith": 61,  // 4.67 guesses, branch 134
+	"false": 21,  // 3.33 guesses, branch 435
+	"fancy": 80,  // 4.50 guesses, branch 435
+	"fanny": 88,  // 5.00 guesses, branch 435
+	"farce": 52,  // 4.00 guesses, branch 435
+	"fatal": 72,  // 4.50 guesses, branch 435
+	"fatty": 87,  // 5.00 guesses, branch 435
+	"fault": 29,  // 4.00 guesses, branch 134
+	"fauna": 28,  // 4.00 guesses, branch 136
+	"favor": 76,  // 4.67 guesses, branch 284
+	"feast": 52,  // 4.00 guesses, branch 435
+	"fecal": 82,  // 4.83 guesses, branch 435
+	"feign": 49,  // 4.00 guesses, branch 265
+	"fella": 84,  // 5.00 guesses, branch 435
+	"felon": 61,  // 4.33 guesses, branch 282
+	"femme": 68,  // 4.67 guesses, branch 197
+	"femur": 51,  // 4.33 guesses, branch 165
+	"fence": 41,  // 4.17 guesses, branch 197
+	"feral": 49,  // 4.00 guesses, branch 435
+	"ferry": 79,  // 5.17 guesses, branch 197
+	"fetal": 28,  // 3.50 guesses, branch 435
+	"fetch": 25,  // 3.67 guesses, branch 197
+	"fetid": 51,  // 4.50 guesses, branch 127
+	"fetus": 26,  // 4.00 guesses, branch 127
+	"fever": 79,  // 5.17 guesses, branch 190
+	"fewer": 85,  // 5.50 guesses, branch 190
+	"fiber": 85,  // 5.17 guesses, branch 265
+	"fibre": 53,  // 4.17 guesses, branch 265
+	"ficus": 29,  // 4.33 guesses, branch 55
+	"field": 27,  // 4.00 guesses, branch 127
+	"fiend": 21,  // 3.67 guesses, branch 165
+	"fiery": 71,  // 4.67 guesses, branch 265
+	"fifth": 65,  // 4.17 guesses, branch 265
+	"fifty": 58,  // 4.00 guesses, branch 265
+	"fight": 83,  // 4.83 guesses, branch 265
+	"filer": 31,  // 3.83 guesses, branch 265
+	"filet": 26,  // 3.67 guesses, branch 265
+	"filly": 89,  // 5.33 guesses, branch 265
+	"filmy": 70,  // 4.33 guesses, branch 265
+	"filth": 36,  // 3.67 guesses, branch 265
+	"final": 59,  // 4.67 guesses, branch 127
+	"finch": 64,  // 4.17 guesses, branch 265
+	"finer": 70,  // 4.67 guesses, branch 265
+	"first": 12,  // 3.17 guesses, branch 265
+	"fishy": 86,  // 5.00 guesses, branch 265
+	"fixer": 99,  // 6.83 guesses, branch 265
+	"fizzy": 95,  // 5.50 guesses, branch 265
+	"fjord": 73,  // 4.50 guesses, branch 221
+	"flack": 94,  // 5.50 guesses, branch 435
+	"flail": 49,  // 4.83 guesses, branch 67
+	"flair": 11,  // 4.00 guesses, branch 67
+	"flake": 83,  // 4.83 guesses, branch 435
+	"flaky": 77,  // 4.33 guesses, branch 435
+	"flame": 88,  // 5.17 guesses, branch 435
+	"flank": 86,  // 4.83 guesses, branch 435
+	"flare": 83,  // 5.00 guesses, branch 435
+	"flash": 78,  // 4.50 guesses, branch 435
+	"flask": 75,  // 4.33 guesses, branch 435
+	"fleck": 52,  // 4.17 guesses, branch 197
+	"fleet": 20,  // 3.83 guesses, branch 190
+	"flesh": 31,  // 3.83 guesses, branch 197
+	"flick": 57,  // 4.00 guesses, branch 265
+	"flier": 24,  // 3.67 guesses, branch 265
+	"fling": 74,  // 4.50 guesses, branch 265
+	"flint": 42,  // 3.83 guesses, branch 265
+	"flirt": 29,  // 3.67 guesses, branch 265
+	"float": 38,  // 3.83 guesses, branch 284
+	"flock": 77,  // 4.50 guesses, branch 282
+	"flood": 52,  // 4.00 guesses, branch 263
+	"floor": 54,  // 4.17 guesses, branch 282
+	"flora": 35,  // 3.83 guesses, branch 284
+	"floss": 91,  // 5.50 guesses, branch 282
+	"flour": 55,  // 4.33 guesses, branch 188
+	"flout": 34,  // 3.67 guesses, branch 263
+	"flown": 87,  // 5.00 guesses, branch 282
+	"fluff": 79,  // 4.50 guesses, branch 263
+	"fluid": 79,  // 4.67 guesses, branch 263
+	"fluke": 36,  // 4.17 guesses, branch 109
+	"flume": 65,  // 4.83 guesses, branch 109
+	"flung": 63,  // 4.17 guesses, branch 246
+	"flunk": 80,  // 4.67 guesses, branch 246
+	"flush": 68,  // 4.33 guesses, branch 263
+	"flute": 16,  // 3.83 guesses, branch 109
+	"flyer": 58,  // 4.50 guesses, branch 190
+	"foamy": 47,  // 3.83 guesses, branch 284
+	"focal": 56,  // 4.17 guesses, branch 284
+	"focus": 85,  // 5.17 guesses, branch 188
+	"foggy": 90,  // 5.17 guesses, branch 282
+	"foist": 18,  // 3.33 guesses, branch 263
+	"folio": 8,   // 3.00 guesses, branch 263
+	"folly": 82,  // 4.83 guesses, branch 282
+	"foray": 79,  // 4.83 guesses, branch 284
+	"force": 36,  // 3.83 guesses, branch 282
+	"forge": 30,  // 3.67 guesses, branch 282
+	"forgo": 82,  // 5.00 guesses, branch 221
+	"forte": 49,  // 4.17 guesses, branch 282
+	"forth": 50,  // 4.00 guesses, branch 282
+	"forty": 91,  // 5.50 guesses, branch 282
+	"forum": 25,  // 3.50 guesses, branch 221
+	"found": 88,  // 5.17 guesses, branch 246
+	"foyer": 89,  // 5.50 guesses, branch 282
+	"frail": 38,  // 4.67 guesses, branch 67
+	"frame": 92,  // 5.50 guesses, branch 435
+	"frank": 95,  // 5.67 guesses, branch 435
+	"fraud": 48,  // 4.50 guesses, branch 102
+	"freak": 83,  // 4.83 guesses, branch 435
+	"freed": 64,  // 4.83 guesses, branch 165
+	"freer": 67,  // 5.00 guesses, branch 190
+	"fresh": 11,  // 3.33 guesses, branch 197
+	"friar": 29,  // 4.17 guesses, branch 136
+	"fried": 40,  // 4.17 guesses, branch 165
+	"frill": 73,  // 4.67 guesses, branch 265
+	"frisk": 60,  // 4.17 guesses, branch 265
+	"fritz": 67,  // 4.33 guesses, branch 265
+	"frock": 86,  // 5.00 guesses, branch 282
+	"frond": 61,  // 4.33 guesses, branch 221
+	"front": 48,  // 4.00 guesses, branch 282
+	"frost": 47,  // 4.00 guesses, branch 282
+	"froth": 58,  // 4.17 guesses, branch 282
+	"frown": 68,  // 4.33 guesses, branch 282
+	"froze": 96,  // 6.17 guesses, branch 282
+	"fruit": 6,   // 3.50 guesses, branch 86
+	"fudge": 70,  // 4.67 guesses, branch 165
+	"fugue": 56,  // 4.33 guesses, branch 165
+	"fully": 90,  // 5.33 guesses, branch 263
+	"fungi": 40,  // 3.67 guesses, branch 246
+	"funky": 87,  // 5.00 guesses, branch 246
+	"funny": 96,  // 6.00 guesses, branch 246
+	"furor": 32,  // 3.83 guesses, branch 221
+	"furry": 91,  // 5.67 guesses, branch 221
+	"fussy": 74,  // 4.50 guesses, branch 263
+	"fuzzy": 98,  // 6.17 guesses, branch 263
+	"gaffe": 90,  // 5.17 guesses, branch 435
+	"gaily": 37,  // 4.17 guesses, branch 134
+	"gamer": 84,  // 5.00 guesses, branch 435
+	"gamma": 82,  // 4.67 guesses, branch 435
+	"gamut": 33,  // 4.00 guesses, branch 134
+	"gassy": 89,  // 5.17 guesses, branch 435
+	"gaudy": 52,  // 4.33 guesses, branch 136
+	"gauge": 9,   // 4.00 guesses, branch 49
+	"gaunt": 22,  // 4.33 guesses, branch 53
+	"gauze": 78,  // 5.67 guesses, branch 49
+	"gavel": 91,  // 5.33 guesses, branch 435
+	"gawky": 98,  // 6.00 guesses, branch 435
+	"gayer": 93,  // 5.67 guesses, branch 435
+	"gayly": 82,  // 4.67 guesses, branch 435
+	"gazer": 99,  // 6.83 guesses, branch 435
+	"gecko": 50,  // 4.17 guesses, branch 197
+	"geeky": 53,  // 4.33 guesses, branch 197
+	"geese": 67,  // 5.00 guesses, branch 197
+	"genie": 5,   // 3.33 guesses, branch 165
+	"genre": 26,  // 4.00 guesses, branch 197
+	"ghost": 52,  // 4.00 guesses, branch 282
+	"ghoul": 61,  // 4.17 guesses, branch 263
+	"giant": 5,   // 3.67 guesses, branch 60
+	"giddy": 58,  // 4.00 guesses, branch 263
+	"gipsy": 62,  // 4.17 guesses, branch 265
+	"girly": 55,  // 4.17 guesses, branch 265
+	"girth": 88,  // 5.33 guesses, branch 265
+	"given": 64,  // 4.33 guesses, branch 265
+	"giver": 92,  // 5.67 guesses, branch 265
+	"glade": 18,  // 4.33 guesses, branch 54
+	"gland": 11,  // 3.83 guesses, branch 67
+	"glare": 90,  // 5.50 guesses, branch 435
+	"glass": 94,  // 5.67 guesses, branch 435
+	"glaze": 89,  // 5.17 guesses, branch 435
+	"gleam": 85,  // 5.00 guesses, branch 435
+	"glean": 51,  // 4.00 guesses, branch 435
+	"glide": 29,  // 4.17 guesses, branch 104
+	"glint": 63,  // 4.33 guesses, branch 265
+	"gloat": 81,  // 5.00 guesses, branch 284
+	"globe": 40,  // 3.83 guesses, branch 282
+	"gloom": 90,  // 5.33 guesses, branch 282
+	"glory": 69,  // 4.50 guesses, branch 282
+	"gloss": 91,  // 5.50 guesses, branch 282
+	"glove": 86,  // 5.17 guesses, branch 282
+	"glyph": 35,  // 3.50 guesses, branch 263
+	"gnash": 61,  // 4.00 guesses, branch 435
+	"gnome": 49,  // 4.00 guesses, branch 282
+	"godly": 54,  // 4.00 guesses, branch 263
+	"going": 87,  // 5.17 guesses, branch 246
+	"golem": 55,  // 4.17 guesses, branch 282
+	"golly": 91,  // 5.50 guesses, branch 282
+	"gonad": 31,  // 4.00 guesses, branch 136
+	"goner": 86,  // 5.33 guesses, branch 282
+	"goody": 93,  // 5.67 guesses, branch 263
+	"gooey": 82,  // 5.00 guesses, branch 282
+	"goofy": 89,  // 5.17 guesses, branch 282
+	"goose": 84,  // 5.17 guesses, branch 282
+	"gorge": 66,  // 4.50 guesses, branch 282
+	"gouge": 81,  // 5.17 guesses, branch 165
+	"gourd": 73,  // 4.67 guesses, branch 221
+	"grace": 65,  // 4.33 guesses, branch 435
+	"grade": 4,   // 3.50 guesses, branch 83
+	"graft": 77,  // 4.50 guesses, branch 435
+	"grail": 36,  // 4.67 guesses, branch 67
+	"grain": 25,  // 4.17 guesses, branch 102
+	"grand": 16,  // 3.83 guesses, branch 102
+	"grant": 79,  // 4.67 guesses, branch 435
+	"grape": 89,  // 5.33 guesses, branch 435
+	"graph": 85,  // 4.83 guesses, branch 435
+	"grasp": 58,  // 4.00 guesses, branch 435
+	"grass": 97,  // 6.17 guesses, branch 435
+	"grate": 74,  // 4.67 guesses, branch 435
+	"grave": 92,  // 5.50 guesses, branch 435
+	"gravy": 86,  // 4.83 guesses, branch 435
+	"graze": 97,  // 6.17 guesses, branch 435
+	"great": 13,  // 3.17 guesses, branch 435
+	"greed": 83,  // 5.50 guesses, branch 165
+	"green": 41,  // 4.33 guesses, branch 190
+	"greet": 31,  // 4.17 guesses, branch 190
+	"grief": 72,  // 4.67 guesses, branch 265
+	"grill": 93,  // 5.83 guesses, branch 265
+	"grime": 95,  // 6.17 guesses, branch 265
+	"grimy": 53,  // 4.00 guesses, branch 265
+	"grind": 37,  // 3.83 guesses, branch 221
+	"gripe": 59,  // 4.33 guesses, branch 265
+	"groan": 20,  // 3.50 guesses, branch 284
+	"groin": 19,  // 3.50 guesses, branch 221
+	"groom": 87,  // 5.17 guesses, branch 282
+	"grope": 36,  // 3.83 guesses, branch 282
+	"gross": 95,  // 6.00 guesses, branch 282
+	"group": 46,  // 4.00 guesses, branch 221
+	"grout": 45,  // 4.17 guesses, branch 188
+	"grove": 93,  // 5.83 guesses, branch 282
+	"growl": 81,  // 4.83 guesses, branch 282
+	"grown": 93,  // 5.67 guesses, branch 282
+	"gruel": 19,  // 4.00 guesses, branch 109
+	"gruff": 68,  // 4.33 guesses, branch 221
+	"grunt": 47,  // 4.17 guesses, branch 188
+	"guard": 23,  // 4.00 guesses, branch 102
+	"guava": 32,  // 4.17 guesses, branch 102
+	"guess": 19,  // 3.83 guesses, branch 127
+	"guest": 49,  // 4.50 guesses, branch 127
+	"guide": 15,  // 3.50 guesses, branch 165
+	"guild": 46,  // 3.83 guesses, branch 263
+	"guile": 20,  // 4.00 guesses, branch 104
+	"guilt": 57,  // 4.17 guesses, branch 263
+	"guise": 15,  // 3.83 guesses, branch 104
+	"gulch": 32,  // 3.67 guesses, branch 188
+	"gully": 88,  // 5.17 guesses, branch 263
+	"gumbo": 77,  // 4.50 guesses, branch 263
+	"gummy": 74,  // 4.33 guesses, branch 263
+	"guppy": 73,  // 4.33 guesses, branch 263
+	"gusto": 19,  // 3.33 guesses, branch 263
+	"gusty": 94,  // 5.83 guesses, branch 263
+	"gypsy": 51,  // 3.83 guesses, branch 263
+	"habit": 30,  // 4.00 guesses, branch 134
+	"hairy": 80,  // 5.33 guesses, branch 136
+	"halve": 63,  // 4.17 guesses, branch 435
+	"handy": 95,  // 6.33 guesses, branch 136
+	"happy": 94,  // 5.50 guesses, branch 435
+	"hardy": 78,  // 5.17 guesses, branch 136
+	"harem": 91,  // 5.50 guesses, branch 435
+	"harpy": 89,  // 5.17 guesses, branch 435
+	"harry": 91,  // 5.50 guesses, branch 435
+	"harsh": 56,  // 4.00 guesses, branch 435
+	"haste": 80,  // 4.83 guesses, branch 435
+	"hasty": 58,  // 4.00 guesses, branch 435
+	"hatch": 97,  // 6.00 guesses, branch 435
+	"hater": 95,  // 6.17 guesses, branch 435
+	"haunt": 10,  // 4.00 guesses, branch 53
+	"haute": 50,  // 5.17 guesses, branch 49
+	"haven": 76,  // 4.50 guesses, branch 435
+	"havoc": 82,  // 4.83 guesses, branch 284
+	"hazel": 91,  // 5.33 guesses, branch 435
+	"heady": 11,  // 3.83 guesses, branch 83
+	"heard": 62,  // 5.17 guesses, branch 83
+	"heart": 54,  // 4.17 guesses, branch 435
+	"heath": 66,  // 4.33 guesses, branch 435
+	"heave": 83,  // 5.00 guesses, branch 435
+	"heavy": 81,  // 4.67 guesses, branch 435
+	"hedge": 31,  // 4.00 guesses, branch 165
+	"hefty": 76,  // 4.83 guesses, branch 197
+	"heist": 92,  // 5.83 guesses, branch 265
+	"helix": 46,  // 4.33 guesses, branch 127
+	"hello": 74,  // 5.00 guesses, branch 197
+	"hence": 62,  // 4.67 guesses, branch 197
+	"heron": 55,  // 4.33 guesses, branch 282
+	"hilly": 96,  // 6.17 guesses, branch 265
+	"hinge": 96,  // 6.17 guesses, branch 265
+	"hippo": 82,  // 4.83 guesses, branch 263
+	"hippy": 93,  // 5.50 guesses, branch 265
+	"hitch": 100, // 7.00 guesses, branch 265
+	"hoard": 9,   // 3.67 guesses, branch 102
+	"hobby": 82,  // 4.67 guesses, branch 282
+	"hoist": 38,  // 3.83 guesses, branch 263
+	"holly": 89,  // 5.33 guesses, branch 282
+	"homer": 91,  // 5.67 guesses, branch 282
+	"honey": 85,  // 5.17 guesses, branch 282
+	"honor": 38,  // 3.83 guesses, branch 282
+	"horde": 52,  // 4.50 guesses, branch 165
+	"horny": 33,  // 3.67 guesses, branch 282
+	"horse": 88,  // 5.50 guesses, branch 282
+	"hotel": 75,  // 4.83 guesses, branch 282
+	"hotly": 65,  // 4.33 guesses, branch 282
+	"hound": 96,  // 6.17 guesses, branch 246
+	"house": 70,  // 5.17 guesses, branch 104
+	"hovel": 75,  // 4.67 guesses, branch 282
+	"hover": 99,  // 7.00 guesses, branch 282
+	"howdy": 81,  // 4.67 guesses, branch 263
+	"human": 50,  // 4.33 guesses, branch 136
+	"humid": 42,  // 3.67 guesses, branch 263
+	"humor": 38,  // 3.83 guesses, branch 221
+	"humph": 85,  // 4.83 guesses, branch 263
+	"humus": 88,  // 5.17 guesses, branch 263
+	"hunch": 84,  // 5.00 guesses, branch 221
+	"hunky": 96,  // 6.00 guesses, branch 246
+	"hurry": 86,  // 5.33 guesses, branch 221
+	"husky": 77,  // 4.50 guesses, branch 263
+	"hussy": 66,  // 4.33 guesses, branch 263
+	"hutch": 90,  // 5.50 guesses, branch 188
+	"hydro": 23,  // 3.50 guesses, branch 221
+	"hyena": 85,  // 5.00 guesses, branch 435
+	"hymen": 57,  // 4.33 guesses, branch 190
+	"hyper": 81,  // 5.17 guesses, branch 190
+	"icily": 48,  // 4.00 guesses, branch 265
+	"icing": 28,  // 3.50 guesses, branch 265
+	"ideal": 4,   // 3.83 guesses, branch 49
+	"idiom": 34,  // 3.67 guesses, branch 263
+	"idiot": 22,  // 3.50 guesses, branch 263
+	"idler": 19,  // 4.00 guesses, branch 122
+	"idyll": 33,  // 3.67 guesses, branch 263
+	"igloo": 39,  // 3.83 guesses, branch 263
+	"iliac": 5,   // 3.67 guesses, branch 67
+	"image": 28,  // 4.33 guesses, branch 83
+	"imbue": 37,  // 4.00 guesses, branch 165
+	"impel": 45,  // 4.00 guesses, branch 265
+	"imply": 55,  // 4.00 guesses, branch 265
+	"inane": 22,  // 4.33 guesses, branch 83
+	"inbox": 55,  // 4.00 guesses, branch 246
+	"incur": 9,   // 3.17 guesses, branch 221
+	"index": 39,  // 4.00 guesses, branch 165
+	"inept": 33,  // 3.83 guesses, branch 265
+	"inert": 34,  // 4.00 guesses, branch 265
+	"infer": 25,  // 3.67 guesses, branch 265
+	"ingot": 14,  // 3.67 guesses, branch 114
+	"inlay": 18,  // 3.83 guesses, branch 127
+	"inlet": 7,   // 3.17 guesses, branch 265
+	"inner": 44,  // 4.17 guesses, branch 265
+	"input": 4,   // 3.17 guesses, branch 113
+	"inter": 58,  // 4.50 guesses, branch 265
+	"intro": 6,   // 3.50 guesses, branch 114
+	"ionic": 57,  // 4.33 guesses, branch 221
+	"irate": 17,  // 4.67 guesses, branch 44
+	"irony": 39,  // 4.00 guesses, branch 221
+	"islet": 7,   // 3.17 guesses, branch 265
+	"issue": 17,  // 4.00 guesses, branch 104
+	"itchy": 52,  // 4.00 guesses, branch 265
+	"ivory": 72,  // 4.67 guesses, branch 221
+	"jaunt": 80,  // 5.67 guesses, branch 53
+	"jazzy": 99,  // 6.17 guesses, branch 435
+	"jelly": 98,  // 6.67 guesses, branch 197
+	"jerky": 77,  // 4.83 guesses, branch 197
+	"jetty": 69,  // 4.67 guesses, branch 197
+	"jewel": 88,  // 5.50 guesses, branch 190
+	"jiffy": 94,  // 5.50 guesses, branch 265
+	"joint": 16,  // 3.67 guesses, branch 113
+	"joist": 81,  // 4.83 guesses, branch 263
+	"joker": 99,  // 7.00 guesses, branch 282
+	"jolly": 99,  // 6.83 guesses, branch 282
+	"joust": 23,  // 3.33 guesses, branch 263
+	"judge": 91,  // 5.67 guesses, branch 165
+	"juice": 39,  // 4.00 guesses, branch 165
+	"juicy": 68,  // 4.33 guesses, branch 221
+	"jumbo": 95,  // 5.67 guesses, branch 263
+	"jumpy": 99,  // 6.50 guesses, branch 263
+	"junta": 13,  // 4.00 guesses, branch 53
+	"junto": 30,  // 3.67 guesses, branch 188
+	"juror": 77,  // 4.83 guesses, branch 221
+	"kappa": 92,  // 5.33 guesses, branch 435
+	"karma": 32,  // 3.50 guesses, branch 435
+	"kayak": 86,  // 4.83 guesses, branch 435
+	"kebab": 85,  // 4.83 guesses, branch 435
+	"khaki": 61,  // 4.67 guesses, branch 102
+	"kinky": 91,  // 5.33 guesses, branch 265
+	"kiosk": 55,  // 4.00 guesses, branch 263
+	"kitty": 96,  // 6.17 guesses, branch 265
+	"knack": 52,  // 3.67 guesses, branch 435
+	"knave": 66,  // 4.17 guesses, branch 435
+	"knead": 17,  // 4.00 guesses, branch 83
+	"kneed": 38,  // 4.17 guesses, branch 165
+	"kneel": 45,  // 4.33 guesses, branch 190
+	"knelt": 28,  // 3.83 guesses, branch 197
+	"knife": 43,  // 3.83 guesses, branch 265
+	"knock": 53,  // 3.83 guesses, branch 282
+	"knoll": 35,  // 3.67 guesses, branch 282
+	"known": 59,  // 4.00 guesses, branch 282
+	"koala": 58,  // 4.33 guesses, branch 284
+	"krill": 97,  // 6.33 guesses, branch 265
+	"label": 65,  // 4.33 guesses, branch 435
+	"labor": 65,  // 4.50 guesses, branch 284
+	"laden": 13,  // 4.33 guesses, branch 48
+	"ladle": 32,  // 4.83 guesses, branch 49
+	"lager": 62,  // 4.33 guesses, branch 435
+	"lance": 64,  // 4.33 guesses, branch 435
+	"lanky": 79,  // 4.50 guesses, branch 435
+	"lapel": 80,  // 4.83 guesses, branch 435
+	"lapse": 33,  // 3.67 guesses, branch 435
+	"large": 47,  // 4.00 guesses, branch 435
+	"larva": 77,  // 4.67 guesses, branch 435
+	"lasso": 48,  // 4.17 guesses, branch 284
+	"latch": 76,  // 4.50 guesses, branch 435
+	"later": 70,  // 4.67 guesses, branch 435
+	"lathe": 13,  // 3.17 guesses, branch 435
+	"latte": 66,  // 4.50 guesses, branch 435
+	"laugh": 63,  // 4.67 guesses, branch 134
+	"layer": 99,  // 7.00 guesses, branch 435
+	"leach": 52,  // 4.00 guesses, branch 435
+	"leafy": 55,  // 4.00 guesses, branch 435
+	"leaky": 85,  // 5.00 guesses, branch 435
+	"leant": 12,  // 3.17 guesses, branch 435
+	"leapt": 25,  // 3.50 guesses, branch 435
+	"learn": 28,  // 3.67 guesses, branch 435
+	"lease": 8,   // 3.17 guesses, branch 435
+	"leash": 41,  // 3.83 guesses, branch 435
+	"least": 29,  // 3.67 guesses, branch 435
+	"leave": 17,  // 3.33 guesses, branch 435
+	"ledge": 5,   // 3.50 guesses, branch 104
+	"leech": 29,  // 4.00 guesses, branch 197
+	"leery": 54,  // 4.67 guesses, branch 197
+	"lefty": 17,  // 3.50 guesses, branch 197
+	"legal": 80,  // 4.83 guesses, branch 435
+	"leggy": 76,  // 4.83 guesses, branch 197
+	"lemon": 44,  // 4.00 guesses, branch 282
+	"lemur": 60,  // 4.83 guesses, branch 122
+	"leper": 68,  // 5.00 guesses, branch 190
+	"level": 22,  // 3.83 guesses, branch 190
+	"lever": 83,  // 5.50 guesses, branch 190
+	"libel": 70,  // 4.67 guesses, branch 265
+	"liege": 27,  // 3.83 guesses, branch 265
+	"light": 59,  // 4.17 guesses, branch 265
+	"liken": 60,  // 4.33 guesses, branch 265
+	"lilac": 14,  // 4.17 guesses, branch 60
+	"limbo": 60,  // 4.17 guesses, branch 263
+	"limit": 39,  // 3.83 guesses, branch 263
+	"linen": 62,  // 4.50 guesses, branch 265
+	"liner": 93,  // 6.00 guesses, branch 265
+	"lingo": 39,  // 3.83 guesses, branch 246
+	"lipid": 27,  // 3.50 guesses, branch 263
+	"lithe": 31,  // 3.83 guesses, branch 265
+	"liver": 92,  // 5.83 guesses, branch 265
+	"livid": 53,  // 4.00 guesses, branch 263
+	"llama": 66,  // 4.33 guesses, branch 435
+	"loamy": 69,  // 4.50 guesses, branch 284
+	"loath": 6,   // 3.00 guesses, branch 284
+	"lobby": 76,  // 4.50 guesses, branch 282
+	"local": 76,  // 4.83 guesses, branch 284
+	"locus": 88,  // 5.50 guesses, branch 188
+	"lodge": 16,  // 3.83 guesses, branch 104
+	"lofty": 45,  // 3.83 guesses, branch 282
+	"logic": 15,  // 3.67 guesses, branch 114
+	"login": 23,  // 3.50 guesses, branch 246
+	"loopy": 81,  // 4.83 guesses, branch 282
+	"loose": 72,  // 4.83 guesses, branch 282
+	"lorry": 86,  // 5.33 guesses, branch 282
+	"loser": 6,   // 3.17 guesses, branch 282
+	"louse": 12,  // 3.83 guesses, branch 104
+	"lousy": 48,  // 4.00 guesses, branch 263
+	"lover": 92,  // 5.83 guesses, branch 282
+	"lower": 97,  // 6.50 guesses, branch 282
+	"lowly": 61,  // 4.17 guesses, branch 282
+	"loyal": 77,  // 4.83 guesses, branch 284
+	"lucid": 14,  // 3.67 guesses, branch 99
+	"lucky": 49,  // 4.00 guesses, branch 188
+	"lumen": 19,  // 3.83 guesses, branch 123
+	"lumpy": 50,  // 3.83 guesses, branch 263
+	"lunar": 15,  // 4.17 guesses, branch 67
+	"lunch": 71,  // 4.67 guesses, branch 188
+	"lunge": 37,  // 4.33 guesses, branch 104
+	"lupus": 72,  // 4.50 guesses, branch 263
+	"lurch": 32,  // 3.83 guesses, branch 188
+	"lurid": 16,  // 3.83 guesses, branch 99
+	"lusty": 32,  // 3.67 guesses, branch 263
+	"lying": 28,  // 3.50 guesses, branch 265
+	"lymph": 59,  // 4.00 guesses, branch 263
+	"lynch": 29,  // 3.67 guesses, branch 190
+	"lyric": 8,   // 3.50 guesses, branch 114
+	"macaw": 73,  // 4.33 guesses, branch 435
+	"macho": 77,  // 4.67 guesses, branch 284
+	"macro": 37,  // 3.83 guesses, branch 284
+	"madam": 55,  // 4.50 guesses, branch 136
+	"madly": 41,  // 4.17 guesses, branch 134
+	"mafia": 52,  // 4.50 guesses, branch 136
+	"magic": 70,  // 4.83 guesses, branch 136
+	"magma": 68,  // 4.17 guesses, branch 435
+	"maize": 26,  // 4.50 guesses, branch 49
+	"major": 27,  // 3.50 guesses, branch 284
+	"maker": 98,  // 6.50 guesses, branch 435
+	"mambo": 90,  // 5.33 guesses, branch 284
+	"mamma": 85,  // 4.83 guesses, branch 435
+	"mammy": 94,  // 5.50 guesses, branch 435
+	"manga": 59,  // 4.00 guesses, branch 435
+	"mange": 80,  // 4.67 guesses, branch 435
+	"mango": 65,  // 4.33 guesses, branch 284
+	"mangy": 83,  // 4.67 guesses, branch 435
+	"mania": 18,  // 3.83 guesses, branch 136
+	"manic": 46,  // 4.33 guesses, branch 136
+	"manly": 78,  // 4.50 guesses, branch 435
+	"manor": 35,  // 3.83 guesses, branch 284
+	"maple": 68,  // 4.33 guesses, branch 435
+	"march": 60,  // 4.00 guesses, branch 435
+	"marry": 61,  // 4.17 guesses, branch 435
+	"marsh": 33,  // 3.50 guesses, branch 435
+	"mason": 54,  // 4.17 guesses, branch 284
+	"masse": 91,  // 5.50 guesses, branch 435
+	"match": 96,  // 5.83 guesses, branch 435
+	"matey": 61,  // 4.17 guesses, branch 435
+	"mauve": 77,  // 5.67 guesses, branch 49
+	"maxim": 79,  // 5.00 guesses, branch 136
+	"maybe": 96,  // 6.00 guesses, branch 435
+	"mayor": 67,  // 4.50 guesses, branch 284
+	"mealy": 23,  // 3.33 guesses, branch 435
+	"meant": 43,  // 3.83 guesses, branch 435
+	"meaty": 67,  // 4.33 guesses, branch 435
+	"mecca": 63,  // 4.17 guesses, branch 435
+	"medal": 11,  // 4.17 guesses, branch 49
+	"media": 6,   // 3.67 guesses, branch 83
+	"medic": 21,  // 3.67 guesses, branch 165
+	"melee": 28,  // 4.17 guesses, branch 190
+	"melon": 71,  // 4.67 guesses, branch 282
+	"mercy": 72,  // 4.83 guesses, branch 197
+	"merge": 38,  // 4.17 guesses, branch 197
+	"merit": 19,  // 4.00 guesses, branch 122
+	"merry": 88,  // 5.67 guesses, branch 197
+	"metal": 57,  // 4.17 guesses, branch 435
+	"meter": 31,  // 4.17 guesses, branch 190
+	"metro": 17,  // 3.67 guesses, branch 197
+	"micro": 34,  // 3.83 guesses, branch 221
+	"midge": 61,  // 4.50 guesses, branch 165
+	"midst": 51,  // 4.00 guesses, branch 263
+	"might": 100, // 7.00 guesses, branch 265
+	"milky": 75,  // 4.50 guesses, branch 265
+	"mimic": 65,  // 4.33 guesses, branch 221
+	"mince": 23,  // 3.50 guesses, branch 265
+	"miner": 88,  // 5.50 guesses, branch 265
+	"minim": 66,  // 4.33 guesses, branch 246
+	"minor": 32,  // 3.83 guesses, branch 221
+	"minty": 60,  // 4.17 guesses, branch 265
+	"minus": 88,  // 5.33 guesses, branch 246
+	"mirth": 97,  // 6.33 guesses, branch 265
+	"miser": 12,  // 3.33 guesses, branch 265
+	"missy": 87,  // 5.17 guesses, branch 265
+	"mocha": 44,  // 3.83 guesses, branch 284
+	"modal": 22,  // 3.83 guesses, branch 134
+	"model": 50,  // 4.50 guesses, branch 127
+	"modem": 78,  // 5.00 guesses, branch 165
+	"mogul": 62,  // 4.17 guesses, branch 263
+	"moist": 93,  // 5.83 guesses, branch 263
+	"molar": 20,  // 3.50 guesses, branch 284
+	"moldy": 78,  // 4.67 guesses, branch 263
+	"money": 90,  // 5.50 guesses, branch 282
+	"month": 72,  // 4.50 guesses, branch 282
+	"moody": 100, // 7.00 guesses, branch 263
+	"moose": 94,  // 6.00 guesses, branch 282
+	"moral": 64,  // 4.50 guesses, branch 284
+	"moron": 47,  // 4.00 guesses, branch 282
+	"morph": 78,  // 4.67 guesses, branch 282
+	"mossy": 72,  // 4.50 guesses, branch 282
+	"motel": 42,  // 4.00 guesses, branch 282
+	"motif": 37,  // 3.67 guesses, branch 263
+	"motor": 29,  // 3.67 guesses, branch 282
+	"motto": 30,  // 3.67 guesses, branch 263
+	"moult": 64,  // 4.33 guesses, branch 263
+	"mound": 100, // 7.00 guesses, branch 246
+	"mount": 80,  // 5.00 guesses, branch 188
+	"mourn": 65,  // 4.50 guesses, branch 221
+	"mouse": 91,  // 6.17 guesses, branch 104
+	"mouth": 93,  // 5.67 guesses, branch 263
+	"mover": 97,  // 6.33 guesses, branch 282
+	"movie": 93,  // 6.00 guesses, branch 165
+	"mower": 90,  // 5.50 guesses, branch 282
+	"mucky": 89,  // 5.17 guesses, branch 221
+	"mucus": 68,  // 4.50 guesses, branch 188
+	"muddy": 91,  // 5.33 guesses, branch 263
+	"mulch": 63,  // 4.33 guesses, branch 188
+	"mummy": 92,  // 5.33 guesses, branch 263
+	"munch": 94,  // 5.83 guesses, branch 221
+	"mural": 24,  // 4.33 guesses, branch 67
+	"murky": 72,  // 4.50 guesses, branch 221
+	"mushy": 86,  // 5.00 guesses, branch 263
+	"music": 1,   // 3.00 guesses, branch 55
+	"musky": 81,  // 4.67 guesses, branch 263
+	"musty": 97,  // 6.17 guesses, branch 263
+	"myrrh": 21,  // 3.50 guesses, branch 221
+	"nadir": 13,  // 3.67 guesses, branch 136
+	"naive": 6,   // 4.00 guesses, branch 45
+	"nanny": 96,  // 6.00 guesses, branch 435
+	"nasal": 56,  // 4.17 guesses, branch 435
+	"nasty": 74,  // 4.50 guesses, branch 435
+	"natal": 69,  // 4.50 guesses, branch 435
+	"naval": 74,  // 4.50 guesses, branch 435
+	"navel": 95,  // 6.00 guesses, branch 435
+	"needy": 27,  // 4.00 guesses, branch 165
+	"neigh": 54,  // 4.17 guesses, branch 265
+	"nerdy": 23,  // 3.83 guesses, branch 165
+	"nerve": 16,  // 3.67 guesses, branch 197
+	"never": 71,  // 5.00 guesses, branch 190
+	"newer": 84,  // 5.50 guesses, branch 190
+	"newly": 74,  // 4.83 guesses, branch 197
+	"nicer": 11,  // 3.33 guesses, branch 265
+	"niche": 44,  // 4.00 guesses, branch 265
+	"niece": 4,   // 3.00 guesses, branch 265
+	"night": 60,  // 4.17 guesses, branch 265
+	"ninja": 75,  // 5.00 guesses, branch 136
+	"ninny": 95,  // 6.00 guesses, branch 265
+	"ninth": 49,  // 4.00 guesses, branch 265
+	"noble": 60,  // 4.33 guesses, branch 282
+	"nobly": 54,  // 4.00 guesses, branch 282
+	"noise": 16,  // 4.00 guesses, branch 104
+	"noisy": 37,  // 3.83 guesses, branch 246
+	"nomad": 24,  // 3.83 guesses, branch 136
+	"noose": 47,  // 4.17 guesses, branch 282
+	"north": 61,  // 4.33 guesses, branch 282
+	"nosey": 50,  // 4.17 guesses, branch 282
+	"notch": 65,  // 4.33 guesses, branch 282
+	"novel": 67,  // 4.50 guesses, branch 282
+	"nudge": 53,  // 4.33 guesses, branch 165
+	"nurse": 33,  // 4.50 guesses, branch 91
+	"nutty": 74,  // 4.83 guesses, branch 188
+	"nylon": 42,  // 3.83 guesses, branch 282
+	"nymph": 58,  // 4.00 guesses, branch 246
+	"oaken": 68,  // 5.33 guesses, branch 76
+	"obese": 37,  // 4.00 guesses, branch 282
+	"occur": 59,  // 4.33 guesses, branch 221
+	"ocean": 15,  // 3.67 guesses, branch 188
+	"octal": 34,  // 3.83 guesses, branch 284
+	"octet": 24,  // 3.67 guesses, branch 282
+	"odder": 16,  // 3.67 guesses, branch 165
+	"oddly": 29,  // 3.50 guesses, branch 263
+	"offal": 60,  // 4.17 guesses, branch 284
+	"offer": 74,  // 4.67 guesses, branch 282
+	"often": 45,  // 4.00 guesses, branch 282
+	"olden": 11,  // 3.67 guesses, branch 123
+	"older": 9,   // 3.67 guesses, branch 122
+	"olive": 9,   // 3.67 guesses, branch 104
+	"ombre": 61,  // 4.33 guesses, branch 282
+	"omega": 41,  // 4.17 guesses, branch 188
+	"onion": 65,  // 4.50 guesses, branch 246
+	"onset": 31,  // 3.83 guesses, branch 282
+	"opera": 63,  // 4.83 guesses, branch 188
+	"opine": 16,  // 3.67 guesses, branch 165
+	"opium": 38,  // 3.67 guesses, branch 263
+	"optic": 34,  // 4.17 guesses, branch 114
+	"orbit": 7,   // 3.50 guesses, branch 114
+	"order": 38,  // 4.33 guesses, branch 165
+	"organ": 43,  // 4.00 guesses, branch 284
+	"other": 12,  // 3.33 guesses, branch 282
+	"otter": 64,  // 4.67 guesses, branch 282
+	"ought": 45,  // 3.83 guesses, branch 263
+	"ounce": 17,  // 3.67 guesses, branch 165
+	"outdo": 48,  // 4.00 guesses, branch 263
+	"outer": 68,  // 5.17 guesses, branch 122
+	"outgo": 69,  // 4.50 guesses, branch 263
+	"ovary": 62,  // 4.33 guesses, branch 284
+	"ovate": 46,  // 4.33 guesses, branch 188
+	"overt": 50,  // 4.17 guesses, branch 282
+	"ovine": 48,  // 4.33 guesses, branch 165
+	"ovoid": 77,  // 4.67 guesses, branch 263
+	"owing": 97,  // 6.17 guesses, branch 246
+	"owner": 58,  // 4.33 guesses, branch 282
+	"oxide": 66,  // 4.67 guesses, branch 165
+	"ozone": 82,  // 5.00 guesses, branch 282
+	"paddy": 93,  // 6.00 guesses, branch 136
+	"pagan": 76,  // 4.50 guesses, branch 435
+	"paint": 70,  // 5.50 guesses, branch 53
+	"paler": 82,  // 5.00 guesses, branch 435
+	"palsy": 77,  // 4.50 guesses, branch 435
+	"panel": 87,  // 5.17 guesses, branch 435
+	"panic": 38,  // 4.17 guesses, branch 136
+	"pansy": 78,  // 4.50 guesses, branch 435
+	"papal": 99,  // 6.67 guesses, branch 435
+	"paper": 96,  // 6.17 guesses, branch 435
+	"parer": 91,  // 5.67 guesses, branch 435
+	"parka": 95,  // 5.83 guesses, branch 435
+	"parry": 97,  // 6.17 guesses, branch 435
+	"parse": 85,  // 5.17 guesses, branch 435
+	"party": 48,  // 3.83 guesses, branch 435
+	"pasta": 77,  // 4.67 guesses, branch 435
+	"paste": 76,  // 4.67 guesses, branch 435
+	"pasty": 96,  // 6.00 guesses, branch 435
+	"patch": 100, // 7.00 guesses, branch 435
+	"patio": 13,  // 3.67 guesses, branch 134
+	"patsy": 89,  // 5.17 guesses, branch 435
+	"patty": 99,  // 6.67 guesses, branch 435
+	"pause": 35,  // 4.83 guesses, branch 49
+	"payee": 79,  // 4.83 guesses, branch 435
+	"payer": 99,  // 6.83 guesses, branch 435
+	"peace": 30,  // 3.67 guesses, branch 435
+	"peach": 69,  // 4.33 guesses, branch 435
+	"pearl": 39,  // 3.83 guesses, branch 435
+	"pecan": 61,  // 4.17 guesses, branch 435
+	"pedal": 7,   // 4.00 guesses, branch 49
+	"penal": 51,  // 4.00 guesses, branch 435
+	"pence": 68,  // 4.83 guesses, branch 197
+	"penne": 78,  // 5.17 guesses, branch 197
+	"penny": 97,  // 6.50 guesses, branch 197
+	"perch": 52,  // 4.33 guesses, branch 197
+	"peril": 33,  // 4.33 guesses, branch 122
+	"perky": 40,  // 4.00 guesses, branch 197
+	"pesky": 59,  // 4.33 guesses, branch 197
+	"pesto": 48,  // 4.33 guesses, branch 197
+	"petal": 41,  // 3.83 guesses, branch 435
+	"petty": 91,  // 5.83 guesses, branch 197
+	"phase": 46,  // 3.83 guesses, branch 435
+	"phone": 74,  // 4.67 guesses, branch 282
+	"phony": 56,  // 4.00 guesses, branch 282
+	"photo": 33,  // 3.67 guesses, branch 263
+	"piano": 6,   // 3.50 guesses, branch 102
+	"picky": 96,  // 6.00 guesses, branch 265
+	"piece": 67,  // 4.67 guesses, branch 265
+	"piety": 28,  // 3.67 guesses, branch 265
+	"piggy": 99,  // 6.67 guesses, branch 265
+	"pilot": 30,  // 3.67 guesses, branch 263
+	"pinch": 96,  // 6.00 guesses, branch 265
+	"piney": 53,  // 4.17 guesses, branch 265
+	"pinky": 99,  // 6.67 guesses, branch 265
+	"pinto": 19,  // 3.83 guesses, branch 114
+	"piper": 89,  // 5.50 guesses, branch 265
+	"pique": 56,  // 4.33 guesses, branch 165
+	"pitch": 94,  // 5.83 guesses, branch 265
+	"pithy": 61,  // 4.17 guesses, branch 265
+	"pivot": 82,  // 4.83 guesses, branch 263
+	"pixel": 92,  // 5.67 guesses, branch 265
+	"pixie": 85,  // 5.33 guesses, branch 165
+	"pizza": 71,  // 4.67 guesses, branch 136
+	"place": 28,  // 3.50 guesses, branch 435
+	"plaid": 14,  // 4.00 guesses, branch 67
+	"plain": 13,  // 4.00 guesses, branch 67
+	"plait": 7,   // 3.83 guesses, branch 60
+	"plane": 77,  // 4.67 guesses, branch 435
+	"plank": 74,  // 4.33 guesses, branch 435
+	"plant": 69,  // 4.33 guesses, branch 435
+	"plate": 86,  // 5.17 guesses, branch 435
+	"plaza": 89,  // 5.17 guesses, branch 435
+	"plead": 17,  // 4.33 guesses, branch 54
+	"pleat": 64,  // 4.33 guesses, branch 435
+	"plied": 57,  // 4.67 guesses, branch 127
+	"plier": 67,  // 4.67 guesses, branch 265
+	"pluck": 26,  // 3.50 guesses, branch 188
+	"plumb": 60,  // 4.00 guesses, branch 263
+	"plume": 80,  // 5.33 guesses, branch 109
+	"plump": 84,  // 4.83 guesses, branch 263
+	"plunk": 90,  // 5.33 guesses, branch 246
+	"plush": 67,  // 4.33 guesses, branch 263
+	"poesy": 38,  // 3.83 guesses, branch 282
+	"point": 63,  // 4.83 guesses, branch 113
+	"poise": 32,  // 4.33 guesses, branch 104
+	"poker": 78,  // 4.83 guesses, branch 282
+	"polar": 56,  // 4.33 guesses, branch 284
+	"polka": 70,  // 4.50 guesses, branch 284
+	"polyp": 47,  // 3.83 guesses, branch 282
+	"pooch": 90,  // 5.33 guesses, branch 282
+	"poppy": 98,  // 6.17 guesses, branch 282
+	"porch": 28,  // 3.50 guesses, branch 282
+	"poser": 92,  // 5.83 guesses, branch 282
+	"posit": 30,  // 3.67 guesses, branch 263
+	"posse": 89,  // 5.50 guesses, branch 282
+	"pouch": 81,  // 4.83 guesses, branch 221
+	"pound": 95,  // 6.00 guesses, branch 246
+	"pouty": 81,  // 4.83 guesses, branch 263
+	"power": 93,  // 5.83 guesses, branch 282
+	"prank": 98,  // 6.50 guesses, branch 435
+	"prawn": 78,  // 4.50 guesses, branch 435
+	"preen": 56,  // 4.67 guesses, branch 190
+	"press": 79,  // 5.17 guesses, branch 197
+	"price": 40,  // 4.00 guesses, branch 265
+	"prick": 53,  // 4.00 guesses, branch 265
+	"pride": 11,  // 3.50 guesses, branch 165
+	"pried": 67,  // 4.83 guesses, branch 165
+	"prime": 51,  // 4.17 guesses, branch 265
+	"primo": 35,  // 3.83 guesses, branch 221
+	"print": 22,  // 3.50 guesses, branch 265
+	"prior": 35,  // 4.00 guesses, branch 221
+	"prism": 41,  // 3.83 guesses, branch 265
+	"privy": 85,  // 5.00 guesses, branch 265
+	"prize": 90,  // 5.50 guesses, branch 265
+	"probe": 60,  // 4.33 guesses, branch 282
+	"prone": 97,  // 6.50 guesses, branch 282
+	"prong": 44,  // 3.83 guesses, branch 282
+	"proof": 71,  // 4.50 guesses, branch 282
+	"prose": 55,  // 4.33 guesses, branch 282
+	"proud": 78,  // 4.83 guesses, branch 221
+	"prove": 98,  // 6.50 guesses, branch 282
+	"prowl": 65,  // 4.33 guesses, branch 282
+	"proxy": 94,  // 5.67 guesses, branch 282
+	"prude": 32,  // 4.00 guesses, branch 165
+	"prune": 72,  // 5.00 guesses, branch 165
+	"psalm": 45,  // 3.67 guesses, branch 435
+	"pubic": 66,  // 4.33 guesses, branch 221
+	"pudgy": 78,  // 4.50 guesses, branch 263
+	"puffy": 95,  // 5.67 guesses, branch 263
+	"pulpy": 75,  // 4.50 guesses, branch 263
+	"pulse": 59,  // 4.83 guesses, branch 104
+	"punch": 98,  // 6.33 guesses, branch 221
+	"pupal": 63,  // 4.67 guesses, branch 134
+	"pupil": 78,  // 4.67 guesses, branch 263
+	"puppy": 100, // 6.83 guesses, branch 263
+	"puree": 34,  // 4.50 guesses, branch 107
+	"purer": 64,  // 4.83 guesses, branch 165
+	"purge": 75,  // 5.00 guesses, branch 165
+	"purse": 72,  // 5.33 guesses, branch 91
+	"pushy": 93,  // 5.67 guesses, branch 263
+	"putty": 97,  // 6.33 guesses, branch 263
+	"pygmy": 31,  // 3.33 guesses, branch 263
+	"quack": 70,  // 4.83 guesses, branch 102
+	"quail": 17,  // 4.00 guesses, branch 67
+	"quake": 44,  // 4.50 guesses, branch 83
+	"qualm": 59,  // 4.83 guesses, branch 67
+	"quark": 60,  // 4.67 guesses, branch 102
+	"quart": 15,  // 4.33 guesses, branch 37
+	"quash": 34,  // 4.50 guesses, branch 49
+	"quasi": 23,  // 4.33 guesses, branch 49
+	"queen": 73,  // 5.00 guesses, branch 165
+	"queer": 95,  // 6.50 guesses, branch 165
+	"quell": 60,  // 4.67 guesses, branch 127
+	"query": 71,  // 4.83 guesses, branch 165
+	"quest": 72,  // 5.00 guesses, branch 127
+	"queue": 75,  // 5.00 guesses, branch 165
+	"quick": 84,  // 4.83 guesses, branch 221
+	"quiet": 36,  // 4.17 guesses, branch 127
+	"quill": 73,  // 4.50 guesses, branch 263
+	"quilt": 88,  // 5.17 guesses, branch 263
+	"quirk": 78,  // 4.67 guesses, branch 221
+	"quite": 12,  // 3.67 guesses, branch 102
+	"quota": 47,  // 4.33 guesses, branch 134
+	"quote": 30,  // 4.17 guesses, branch 102
+	"quoth": 56,  // 4.00 guesses, branch 263
+	"rabbi": 72,  // 5.00 guesses, branch 136
+	"rabid": 21,  // 3.83 guesses, branch 136
+	"racer": 89,  // 5.50 guesses, branch 435
+	"radar": 47,  // 4.67 guesses, branch 136
+	"radii": 54,  // 4.67 guesses, branch 136
+	"radio": 72,  // 5.17 guesses, branch 136
+	"rainy": 23,  // 4.00 guesses, branch 136
+	"raise": 0,   // 3.17 guesses, branch 44
+	"rajah": 75,  // 4.50 guesses, branch 435
+	"rally": 84,  // 5.00 guesses, branch 435
+	"ralph": 80,  // 4.67 guesses, branch 435
+	"ramen": 41,  // 3.83 guesses, branch 435
+	"ranch": 56,  // 4.00 guesses, branch 435
+	"randy": 34,  // 4.17 guesses, branch 136
+	"range": 63,  // 4.33 guesses, branch 435
+	"rapid": 34,  // 4.17 guesses, branch 136
+	"rarer": 93,  // 6.00 guesses, branch 435
+	"raspy": 56,  // 4.00 guesses, branch 435
+	"ratio": 11,  // 4.17 guesses, branch 65
+	"ratty": 59,  // 4.17 guesses, branch 435
+	"raven": 35,  // 3.67 guesses, branch 435
+	"rayon": 70,  // 4.67 guesses, branch 284
+	"razor": 89,  // 5.50 guesses, branch 284
+	"reach": 14,  // 3.17 guesses, branch 435
+	"react": 11,  // 3.17 guesses, branch 435
+	"ready": 5,   // 3.67 guesses, branch 83
+	"realm": 18,  // 3.33 guesses, branch 435
+	"rearm": 60,  // 4.33 guesses, branch 435
+	"rebar": 37,  // 3.83 guesses, branch 435
+	"rebel": 24,  // 4.00 guesses, branch 190
+	"rebus": 38,  // 4.33 guesses, branch 122
+	"rebut": 22,  // 4.00 guesses, branch 122
+	"recap": 64,  // 4.33 guesses, branch 435
+	"recur": 18,  // 3.83 guesses, branch 165
+	"recut": 18,  // 4.00 guesses, branch 109
+	"reedy": 30,  // 4.17 guesses, branch 165
+	"refer": 61,  // 4.83 guesses, branch 190
+	"refit": 51,  // 4.67 guesses, branch 122
+	"regal": 74,  // 4.67 guesses, branch 435
+	"rehab": 84,  // 5.00 guesses, branch 435
+	"reign": 31,  // 3.83 guesses, branch 265
+	"relax": 35,  // 3.67 guesses, branch 435
+	"relay": 73,  // 4.67 guesses, branch 435
+	"relic": 11,  // 4.17 guesses, branch 61
+	"remit": 83,  // 5.67 guesses, branch 122
+	"renal": 59,  // 4.33 guesses, branch 435
+	"renew": 20,  // 3.83 guesses, branch 190
+	"repay": 89,  // 5.33 guesses, branch 435
+	"repel": 73,  // 5.17 guesses, branch 190
+	"reply": 41,  // 4.17 guesses, branch 197
+	"rerun": 46,  // 4.50 guesses, branch 165
+	"reset": 6,   // 3.50 guesses, branch 190
+	"resin": 2,   // 3.17 guesses, branch 113
+	"retch": 32,  // 4.00 guesses, branch 197
+	"retro": 23,  // 4.00 guesses, branch 197
+	"retry": 42,  // 4.33 guesses, branch 197
+	"reuse": 3,   // 3.50 guesses, branch 109
+	"revel": 94,  // 6.33 guesses, branch 190
+	"revue": 69,  // 5.00 guesses, branch 165
+	"rhino": 24,  // 3.67 guesses, branch 221
+	"rhyme": 54,  // 4.33 guesses, branch 197
+	"rider": 12,  // 3.67 guesses, branch 165
+	"ridge": 30,  // 4.00 guesses, branch 165
+	"rifle": 40,  // 4.00 guesses, branch 265
+	"right": 88,  // 5.33 guesses, branch 265
+	"rigid": 36,  // 3.83 guesses, branch 221
+	"rigor": 36,  // 4.00 guesses, branch 221
+	"rinse": 15,  // 3.50 guesses, branch 265
+	"ripen": 17,  // 3.50 guesses, branch 265
+	"riper": 84,  // 5.33 guesses, branch 265
+	"risen": 27,  // 3.83 guesses, branch 265
+	"riser": 23,  // 3.83 guesses, branch 265
+	"risky": 50,  // 4.00 guesses, branch 265
+	"rival": 12,  // 4.00 guesses, branch 67
+	"river": 97,  // 6.50 guesses, branch 265
+	"rivet": 56,  // 4.33 guesses, branch 265
+	"roach": 28,  // 3.67 guesses, branch 284
+	"roast": 29,  // 3.83 guesses, branch 284
+	"robin": 9,   // 3.17 guesses, branch 221
+	"robot": 29,  // 3.67 guesses, branch 282
+	"rocky": 37,  // 3.67 guesses, branch 282
+	"rodeo": 18,  // 3.83 guesses, branch 165
+	"roger": 66,  // 4.67 guesses, branch 282
+	"rogue": 11,  // 3.50 guesses, branch 165
+	"roomy": 75,  // 4.67 guesses, branch 282
+	"roost": 40,  // 4.00 guesses, branch 282
+	"rotor": 45,  // 4.17 guesses, branch 282
+	"rouge": 11,  // 3.50 guesses, branch 165
+	"rough": 54,  // 4.17 guesses, branch 221
+	"round": 42,  // 4.00 guesses, branch 221
+	"rouse": 1,   // 3.17 guesses, branch 59
+	"route": 10,  // 4.17 guesses, branch 59
+	"rover": 98,  // 7.00 guesses, branch 282
+	"rowdy": 69,  // 4.50 guesses, branch 221
+	"rower": 90,  // 5.67 guesses, branch 282
+	"royal": 74,  // 4.83 guesses, branch 284
+	"ruddy": 83,  // 5.00 guesses, branch 221
+	"ruder": 25,  // 4.00 guesses, branch 165
+	"rugby": 51,  // 4.00 guesses, branch 221
+	"ruler": 29,  // 4.33 guesses, branch 122
+	"rumba": 24,  // 3.83 guesses, branch 136
+	"rumor": 47,  // 4.17 guesses, branch 221
+	"rupee": 20,  // 4.17 guesses, branch 107
+	"rural": 88,  // 6.33 guesses, branch 67
+	"rusty": 22,  // 3.67 guesses, branch 188
+	"sadly": 10,  // 3.50 guesses, branch 134
+	"safer": 57,  // 4.17 guesses, branch 435
+	"saint": 0,   // 2.83 guesses, branch 53
+	"salad": 5,   // 3.33 guesses, branch 134
+	"sally": 47,  // 3.83 guesses, branch 435
+	"salon": 25,  // 3.67 guesses, branch 284
+	"salsa": 40,  // 3.83 guesses, branch 435
+	"salty": 68,  // 4.33 guesses, branch 435
+	"salve": 66,  // 4.33 guesses, branch 435
+	"salvo": 62,  // 4.33 guesses, branch 284
+	"sandy": 44,  // 4.33 guesses, branch 127
+	"saner": 94,  // 6.00 guesses, branch 435
+	"sappy": 97,  // 6.00 guesses, branch 435
+	"sassy": 86,  // 5.00 guesses, branch 435
+	"satin": 2,   // 3.50 guesses, branch 60
+	"satyr": 36,  // 3.67 guesses, branch 435
+	"sauce": 1,   // 3.67 guesses, branch 32
+	"saucy": 15,  // 4.33 guesses, branch 37
+	"sauna": 4,   // 3.33 guesses, branch 127
+	"saute": 1,   // 3.17 guesses, branch 49
+	"savor": 60,  // 4.33 guesses, branch 284
+	"savoy": 76,  // 4.67 guesses, branch 284
+	"savvy": 98,  // 6.17 guesses, branch 435
+	"scald": 15,  // 4.17 guesses, branch 54
+	"scale": 13,  // 3.17 guesses, branch 435
+	"scalp": 65,  // 4.17 guesses, branch 435
+	"scaly": 94,  // 5.67 guesses, branch 435
+	"scamp": 48,  // 3.67 guesses, branch 435
+	"scant": 48,  // 3.83 guesses, branch 435
+	"scare": 16,  // 3.33 guesses, branch 435
+	"scarf": 42,  // 3.67 guesses, branch 435
+	"scary": 92,  // 5.50 guesses, branch 435
+	"scene": 3,   // 3.00 guesses, branch 197
+	"scent": 6,   // 3.17 guesses, branch 197
+	"scion": 7,   // 3.50 guesses, branch 102
+	"scoff": 87,  // 5.00 guesses, branch 282
+	"scold": 8,   // 3.83 guesses, branch 55
+	"scone": 6,   // 3.00 guesses, branch 282
+	"scoop": 51,  // 4.00 guesses, branch 282
+	"scope": 45,  // 4.00 guesses, branch 282
+	"score": 38,  // 4.00 guesses, branch 282
+	"scorn": 45,  // 4.00 guesses, branch 282
+	"scour": 28,  // 3.83 guesses, branch 188
+	"scout": 23,  // 3.67 guesses, branch 188
+	"scowl": 67,  // 4.33 guesses, branch 282
+	"scram": 95,  // 5.83 guesses, branch 435
+	"scrap": 75,  // 4.50 guesses, branch 435
+	"scree": 5,   // 3.33 guesses, branch 190
+	"screw": 20,  // 3.67 guesses, branch 190
+	"scrub": 6,   // 3.00 guesses, branch 188
+	"scrum": 42,  // 4.00 guesses, branch 188
+	"scuba": 12,  // 4.17 guesses, branch 37
+	"sedan": 1,   // 3.33 guesses, branch 48
+	"seedy": 4,   // 3.33 guesses, branch 127
+	"segue": 24,  // 4.17 guesses, branch 109
+	"seize": 68,  // 4.67 guesses, branch 265
+	"semen": 21,  // 3.83 guesses, branch 190
+	"sense": 13,  // 3.67 guesses, branch 197
+	"sepia": 2,   // 3.67 guesses, branch 48
+	"serif": 36,  // 4.33 guesses, branch 122
+	"serum": 53,  // 4.67 guesses, branch 122
+	"serve": 59,  // 4.67 guesses, branch 197
+	"setup": 18,  // 3.83 guesses, branch 127
+	"seven": 54,  // 4.50 guesses, branch 190
+	"sever": 87,  // 5.67 guesses, branch 190
+	"sewer": 86,  // 5.67 guesses, branch 190
+	"shack": 64,  // 4.00 guesses, branch 435
+	"shade": 12,  // 4.17 guesses, branch 54
+	"shady": 13,  // 4.00 guesses, branch 54
+	"shaft": 54,  // 3.83 guesses, branch 435
+	"shake": 83,  // 4.83 guesses, branch 435
+	"shaky": 94,  // 5.50 guesses, branch 435
+	"shale": 41,  // 3.83 guesses, branch 435
+	"shall": 62,  // 4.17 guesses, branch 435
+	"shalt": 18,  // 3.17 guesses, branch 435
+	"shame": 79,  // 4.67 guesses, branch 435
+	"shank": 47,  // 3.67 guesses, branch 435
+	"shape": 82,  // 4.83 guesses, branch 435
+	"shard": 9,   // 4.00 guesses, branch 54
+	"share": 62,  // 4.33 guesses, branch 435
+	"shark": 81,  // 4.67 guesses, branch 435
+	"sharp": 75,  // 4.50 guesses, branch 435
+	"shave": 91,  // 5.33 guesses, branch 435
+	"shawl": 54,  // 3.83 guesses, branch 435
+	"shear": 30,  // 3.67 guesses, branch 435
+	"sheen": 27,  // 4.00 guesses, branch 190
+	"sheep": 61,  // 4.67 guesses, branch 190
+	"sheer": 12,  // 3.67 guesses, branch 190
+	"sheet": 4,   // 3.17 guesses, branch 190
+	"sheik": 21,  // 3.83 guesses, branch 127
+	"shelf": 62,  // 4.50 guesses, branch 197
+	"shell": 40,  // 4.17 guesses, branch 197
+	"shied": 13,  // 3.67 guesses, branch 127
+	"shift": 29,  // 3.50 guesses, branch 265
+	"shine": 14,  // 3.33 guesses, branch 265
+	"shiny": 59,  // 4.17 guesses, branch 265
+	"shire": 38,  // 4.00 guesses, branch 265
+	"shirk": 58,  // 4.17 guesses, branch 265
+	"shirt": 35,  // 3.83 guesses, branch 265
+	"shoal": 15,  // 3.33 guesses, branch 284
+	"shock": 41,  // 3.67 guesses, branch 282
+	"shone": 35,  // 3.83 guesses, branch 282
+	"shook": 54,  // 4.00 guesses, branch 282
+	"shoot": 79,  // 4.83 guesses, branch 282
+	"shore": 68,  // 4.67 guesses, branch 282
+	"shorn": 54,  // 4.17 guesses, branch 282
+	"short": 45,  // 4.00 guesses, branch 282
+	"shout": 75,  // 4.67 guesses, branch 263
+	"shove": 83,  // 5.00 guesses, branch 282
+	"shown": 55,  // 4.00 guesses, branch 282
+	"showy": 86,  // 5.00 guesses, branch 282
+	"shrew": 59,  // 4.50 guesses, branch 190
+	"shrub": 43,  // 4.00 guesses, branch 188
+	"shrug": 27,  // 3.67 guesses, branch 188
+	"shuck": 33,  // 3.67 guesses, branch 188
+	"shunt": 9,   // 3.17 guesses, branch 188
+	"shush": 45,  // 3.83 guesses, branch 263
+	"shyly": 45,  // 3.83 guesses, branch 263
+	"siege": 10,  // 3.33 guesses, branch 265
+	"sieve": 54,  // 4.33 guesses, branch 265
+	"sight": 71,  // 4.50 guesses, branch 265
+	"sigma": 24,  // 3.83 guesses, branch 134
+	"silky": 52,  // 4.00 guesses, branch 265
+	"silly": 38,  // 3.83 guesses, branch 265
+	"since": 32,  // 3.83 guesses, branch 265
+	"sinew": 52,  // 4.17 guesses, branch 265
+	"singe": 77,  // 4.83 guesses, branch 265
+	"siren": 43,  // 4.17 guesses, branch 265
+	"sissy": 93,  // 5.83 guesses, branch 265
+	"sixth": 55,  // 4.00 guesses, branch 265
+	"sixty": 73,  // 4.50 guesses, branch 265
+	"skate": 15,  // 3.17 guesses, branch 435
+	"skier": 48,  // 4.17 guesses, branch 265
+	"skiff": 73,  // 4.33 guesses, branch 265
+	"skill": 56,  // 4.17 guesses, branch 265
+	"skimp": 58,  // 4.00 guesses, branch 265
+	"skirt": 46,  // 4.00 guesses, branch 265
+	"skulk": 70,  // 4.33 guesses, branch 263
+	"skull": 44,  // 3.83 guesses, branch 263
+	"skunk": 50,  // 3.83 guesses, branch 246
+	"slack": 53,  // 3.83 guesses, branch 435
+	"slain": 3,   // 3.50 guesses, branch 60
+	"slang": 34,  // 3.50 guesses, branch 435
+	"slant": 22,  // 3.33 guesses, branch 435
+	"slash": 79,  // 4.67 guesses, branch 435
+	"slate": 5,   // 2.83 guesses, branch 435
+	"slave": 84,  // 5.00 guesses, branch 435
+	"sleek": 7,   // 3.33 guesses, branch 190
+	"sleep": 26,  // 4.00 guesses, branch 190
+	"sleet": 2,   // 3.00 guesses, branch 190
+	"slept": 4,   // 3.00 guesses, branch 197
+	"slice": 18,  // 3.50 guesses, branch 265
+	"slick": 44,  // 3.83 guesses, branch 265
+	"slide": 8,   // 3.67 guesses, branch 104
+	"slime": 75,  // 4.83 guesses, branch 265
+	"slimy": 51,  // 4.00 guesses, branch 265
+	"sling": 49,  // 4.00 guesses, branch 265
+	"slink": 43,  // 3.83 guesses, branch 265
+	"sloop": 31,  // 3.67 guesses, branch 282
+	"slope": 34,  // 3.83 guesses, branch 282
+	"slosh": 63,  // 4.33 guesses, branch 282
+	"sloth": 24,  // 3.50 guesses, branch 282
+	"slump": 79,  // 4.67 guesses, branch 263
+	"slung": 33,  // 3.67 guesses, branch 246
+	"slunk": 51,  // 4.00 guesses, branch 246
+	"slurp": 23,  // 3.67 guesses, branch 188
+	"slush": 80,  // 4.83 guesses, branch 263
+	"slyly": 49,  // 4.00 guesses, branch 263
+	"smack": 58,  // 3.83 guesses, branch 435
+	"small": 75,  // 4.50 guesses, branch 435
+	"smart": 94,  // 5.83 guesses, branch 435
+	"smash": 72,  // 4.33 guesses, branch 435
+	"smear": 90,  // 5.50 guesses, branch 435
+	"smell": 83,  // 5.33 guesses, branch 197
+	"smelt": 6,   // 3.17 guesses, branch 197
+	"smile": 26,  // 3.67 guesses, branch 265
+	"smirk": 84,  // 5.00 guesses, branch 265
+	"smite": 70,  // 4.67 guesses, branch 265
+	"smith": 20,  // 3.33 guesses, branch 265
+	"smock": 80,  // 4.67 guesses, branch 282
+	"smoke": 80,  // 4.83 guesses, branch 282
+	"smoky": 89,  // 5.17 guesses, branch 282
+	"smote": 76,  // 4.83 guesses, branch 282
+	"snack": 68,  // 4.17 guesses, branch 435
+	"snail": 25,  // 4.50 guesses, branch 60
+	"snake": 78,  // 4.67 guesses, branch 435
+	"snaky": 85,  // 4.83 guesses, branch 435
+	"snare": 60,  // 4.33 guesses, branch 435
+	"snarl": 76,  // 4.67 guesses, branch 435
+	"sneak": 67,  // 4.33 guesses, branch 435
+	"sneer": 84,  // 5.67 guesses, branch 190
+	"snide": 4,   // 3.33 guesses, branch 104
+	"sniff": 56,  // 4.00 guesses, branch 265
+	"snipe": 50,  // 4.17 guesses, branch 265
+	"snoop": 75,  // 4.67 guesses, branch 282
+	"snore": 71,  // 4.83 guesses, branch 282
+	"snort": 34,  // 3.83 guesses, branch 282
+	"snout": 22,  // 3.67 guesses, branch 188
+	"snowy": 74,  // 4.50 guesses, branch 282
+	"snuck": 24,  // 3.50 guesses, branch 188
+	"snuff": 57,  // 4.00 guesses, branch 246
+	"soapy": 48,  // 4.00 guesses, branch 284
+	"sober": 40,  // 4.00 guesses, branch 282
+	"soggy": 86,  // 5.00 guesses, branch 282
+	"solar": 66,  // 4.67 guesses, branch 284
+	"solid": 38,  // 3.83 guesses, branch 263
+	"solve": 53,  // 4.17 guesses, branch 282
+	"sonar": 17,  // 3.50 guesses, branch 284
+	"sonic": 9,   // 3.50 guesses, branch 114
+	"sooth": 12,  // 3.17 guesses, branch 282
+	"sooty": 54,  // 4.17 guesses, branch 282
+	"sorry": 57,  // 4.33 guesses, branch 282
+	"sound": 13,  // 3.17 guesses, branch 246
+	"south": 9,   // 3.00 guesses, branch 263
+	"sower": 94,  // 6.00 guesses, branch 282
+	"space": 37,  // 3.67 guesses, branch 435
+	"spade": 47,  // 5.00 guesses, branch 54
+	"spank": 86,  // 4.83 guesses, branch 435
+	"spare": 82,  // 5.00 guesses, branch 435
+	"spark": 71,  // 4.33 guesses, branch 435
+	"spasm": 61,  // 4.00 guesses, branch 435
+	"spawn": 69,  // 4.17 guesses, branch 435
+	"speak": 56,  // 4.00 guesses, branch 435
+	"spear": 98,  // 6.50 guesses, branch 435
+	"speck": 43,  // 4.00 guesses, branch 197
+	"speed": 51,  // 4.67 guesses, branch 127
+	"spell": 25,  // 3.83 guesses, branch 197
+	"spelt": 25,  // 3.83 guesses, branch 197
+	"spend": 42,  // 4.33 guesses, branch 123
+	"spent": 58,  // 4.50 guesses, branch 197
+	"sperm": 28,  // 3.83 guesses, branch 197
+	"spice": 36,  // 3.83 guesses, branch 265
+	"spicy": 72,  // 4.50 guesses, branch 265
+	"spied": 42,  // 4.33 guesses, branch 127
+	"spiel": 49,  // 4.17 guesses, branch 265
+	"spike": 83,  // 5.00 guesses, branch 265
+	"spiky": 56,  // 4.00 guesses, branch 265
+	"spill": 85,  // 5.17 guesses, branch 265
+	"spilt": 30,  // 3.67 guesses, branch 265
+	"spine": 58,  // 4.33 guesses, branch 265
+	"spiny": 71,  // 4.50 guesses, branch 265
+	"spire": 46,  // 4.17 guesses, branch 265
+	"spite": 95,  // 6.17 guesses, branch 265
+	"splat": 24,  // 3.33 guesses, branch 435
+	"split": 17,  // 3.33 guesses, branch 263
+	"spoil": 39,  // 3.83 guesses, branch 263
+	"spoke": 63,  // 4.33 guesses, branch 282
+	"spoof": 54,  // 4.00 guesses, branch 282
+	"spook": 79,  // 4.67 guesses, branch 282
+	"spool": 74,  // 4.67 guesses, branch 282
+	"spoon": 88,  // 5.33 guesses, branch 282
+	"spore": 31,  // 3.83 guesses, branch 282
+	"sport": 53,  // 4.17 guesses, branch 282
+	"spout": 92,  // 5.67 guesses, branch 263
+	"spray": 75,  // 4.50 guesses, branch 435
+	"spree": 55,  // 4.67 guesses, branch 190
+	"sprig": 27,  // 4.00 guesses, branch 114
+	"spunk": 63,  // 4.17 guesses, branch 246
+	"spurn": 24,  // 3.67 guesses, branch 188
+	"spurt": 23,  // 3.67 guesses, branch 188
+	"squad": 29,  // 3.83 guesses, branch 134
+	"squat": 24,  // 3.83 guesses, branch 134
+	"squib": 44,  // 3.67 guesses, branch 263
+	"stack": 82,  // 4.67 guesses, branch 435
+	"staff": 92,  // 5.33 guesses, branch 435
+	"stage": 42,  // 3.83 guesses, branch 435
+	"staid": 61,  // 5.33 guesses, branch 49
+	"stain": 2,   // 3.50 guesses, branch 60
+	"stair": 0,   // 3.00 guesses, branch 60
+	"stake": 66,  // 4.33 guesses, branch 435
+	"stale": 11,  // 3.17 guesses, branch 435
+	"stalk": 26,  // 3.33 guesses, branch 435
+	"stall": 65,  // 4.33 guesses, branch 435
+	"stamp": 72,  // 4.33 guesses, branch 435
+	"stand": 14,  // 4.17 guesses, branch 54
+	"stank": 89,  // 5.17 guesses, branch 435
+	"stare": 83,  // 5.17 guesses, branch 435
+	"stark": 24,  // 3.33 guesses, branch 435
+	"start": 99,  // 6.83 guesses, branch 435
+	"stash": 55,  // 4.00 guesses, branch 435
+	"state": 87,  // 5.33 guesses, branch 435
+	"stave": 99,  // 6.83 guesses, branch 435
+	"stead": 1,   // 3.17 guesses, branch 54
+	"steak": 36,  // 3.67 guesses, branch 435
+	"steal": 16,  // 3.33 guesses, branch 435
+	"steam": 80,  // 4.83 guesses, branch 435
+	"steed": 12,  // 3.83 guesses, branch 127
+	"steel": 3,   // 3.17 guesses, branch 190
+	"steep": 95,  // 6.50 guesses, branch 190
+	"steer": 76,  // 5.33 guesses, branch 190
+	"stein": 5,   // 3.83 guesses, branch 58
+	"stern": 20,  // 3.83 guesses, branch 197
+	"stick": 71,  // 4.50 guesses, branch 265
+	"stiff": 79,  // 4.67 guesses, branch 265
+	"still": 42,  // 4.00 guesses, branch 265
+	"stilt": 57,  // 4.33 guesses, branch 265
+	"sting": 49,  // 4.00 guesses, branch 265
+	"stink": 58,  // 4.17 guesses, branch 265
+	"stint": 59,  // 4.33 guesses, branch 265
+	"stock": 53,  // 4.00 guesses, branch 282
+	"stoic": 23,  // 4.00 guesses, branch 114
+	"stoke": 36,  // 3.83 guesses, branch 282
+	"stole": 11,  // 3.33 guesses, branch 282
+	"stomp": 53,  // 4.00 guesses, branch 282
+	"stone": 62,  // 4.50 guesses, branch 282
+	"stony": 96,  // 6.17 guesses, branch 282
+	"stood": 67,  // 4.50 guesses, branch 263
+	"stool": 35,  // 3.83 guesses, branch 282
+	"stoop": 47,  // 4.00 guesses, branch 282
+	"store": 58,  // 4.50 guesses, branch 282
+	"stork": 55,  // 4.17 guesses, branch 282
+	"storm": 73,  // 4.67 guesses, branch 282
+	"story": 87,  // 5.33 guesses, branch 282
+	"stout": 87,  // 5.33 guesses, branch 263
+	"stove": 96,  // 6.33 guesses, branch 282
+	"strap": 78,  // 4.67 guesses, branch 435
+	"straw": 98,  // 6.67 guesses, branch 435
+	"stray": 99,  // 7.00 guesses, branch 435
+	"strip": 38,  // 4.33 guesses, branch 114
+	"strut": 25,  // 3.83 guesses, branch 188
+	"stuck": 45,  // 4.00 guesses, branch 188
+	"study": 66,  // 4.33 guesses, branch 263
+	"stuff": 94,  // 5.67 guesses, branch 263
+	"stump": 79,  // 4.67 guesses, branch 263
+	"stung": 34,  // 3.83 guesses, branch 188
+	"stunk": 66,  // 4.50 guesses, branch 188
+	"stunt": 60,  // 4.50 guesses, branch 188
+	"style": 55,  // 4.50 guesses, branch 197
+	"suave": 35,  // 4.83 guesses, branch 44
+	"sugar": 12,  // 4.00 guesses, branch 65
+	"suing": 50,  // 4.00 guesses, branch 246
+	"suite": 0,   // 2.67 guesses, branch 102
+	"sulky": 39,  // 3.67 guesses, branch 263
+	"sully": 63,  // 4.33 guesses, branch 263
+	"sumac": 30,  // 4.67 guesses, branch 37
+	"sunny": 76,  // 4.67 guesses, branch 246
+	"super": 81,  // 5.50 guesses, branch 122
+	"surer": 61,  // 5.00 guesses, branch 122
+	"surge": 16,  // 4.00 guesses, branch 91
+	"surly": 53,  // 4.33 guesses, branch 188
+	"sushi": 50,  // 4.00 guesses, branch 263
+	"swami": 28,  // 4.33 guesses, branch 60
+	"swamp": 77,  // 4.33 guesses, branch 435
+	"swarm": 89,  // 5.17 guesses, branch 435
+	"swash": 93,  // 5.50 guesses, branch 435
+	"swath": 88,  // 5.00 guesses, branch 435
+	"swear": 91,  // 5.50 guesses, branch 435
+	"sweat": 84,  // 5.00 guesses, branch 435
+	"sweep": 69,  // 4.83 guesses, branch 190
+	"sweet": 77,  // 5.17 guesses, branch 190
+	"swell": 98,  // 6.83 guesses, branch 197
+	"swept": 47,  // 4.17 guesses, branch 197
+	"swift": 74,  // 4.50 guesses, branch 265
+	"swill": 94,  // 5.83 guesses, branch 265
+	"swine": 87,  // 5.33 guesses, branch 265
+	"swing": 90,  // 5.33 guesses, branch 265
+	"swirl": 73,  // 4.67 guesses, branch 265
+	"swish": 78,  // 4.67 guesses, branch 265
+	"swoon": 81,  // 4.83 guesses, branch 282
+	"swoop": 93,  // 5.67 guesses, branch 282
+	"sword": 23,  // 4.00 guesses, branch 90
+	"swore": 99,  // 7.00 guesses, branch 282
+	"sworn": 75,  // 4.67 guesses, branch 282
+	"swung": 56,  // 4.00 guesses, branch 246
+	"synod": 41,  // 3.83 guesses, branch 246
+	"syrup": 70,  // 4.67 guesses, branch 188
+	"tabby": 75,  // 4.33 guesses, branch 435
+	"table": 76,  // 4.67 guesses, branch 435
+	"taboo": 52,  // 4.17 guesses, branch 284
+	"tacit": 14,  // 4.17 guesses, branch 60
+	"tacky": 74,  // 4.33 guesses, branch 435
+	"taffy": 89,  // 5.00 guesses, branch 435
+	"taint": 59,  // 5.33 guesses, branch 51
+	"taken": 53,  // 4.00 guesses, branch 435
+	"taker": 63,  // 4.33 guesses, branch 435
+	"tally": 82,  // 4.83 guesses, branch 435
+	"talon": 32,  // 3.83 guesses, branch 284
+	"tamer": 92,  // 5.67 guesses, branch 435
+	"tango": 46,  // 4.00 guesses, branch 284
+	"tangy": 53,  // 3.83 guesses, branch 435
+	"taper": 96,  // 6.17 guesses, branch 435
+	"tapir": 34,  // 4.67 guesses, branch 65
+	"tardy": 38,  // 4.67 guesses, branch 65
+	"tarot": 71,  // 4.83 guesses, branch 284
+	"taste": 90,  // 5.50 guesses, branch 435
+	"tasty": 79,  // 4.67 guesses, branch 435
+	"tatty": 98,  // 6.50 guesses, branch 435
+	"taunt": 67,  // 5.50 guesses, branch 51
+	"tawny": 67,  // 4.17 guesses, branch 435
+	"teach": 15,  // 3.17 guesses, branch 435
+	"teary": 16,  // 3.33 guesses, branch 435
+	"tease": 8,   // 3.17 guesses, branch 435
+	"teddy": 44,  // 4.33 guesses, branch 127
+	"teeth": 4,   // 3.17 guesses, branch 197
+	"tempo": 37,  // 4.00 guesses, branch 197
+	"tenet": 38,  // 4.33 guesses, branch 190
+	"tenor": 10,  // 3.33 guesses, branch 282
+	"tense": 18,  // 3.83 guesses, branch 197
+	"tenth": 41,  // 4.17 guesses, branch 197
+	"tepee": 43,  // 4.50 guesses, branch 190
+	"tepid": 13,  // 3.67 guesses, branch 127
+	"terra": 39,  // 4.00 guesses, branch 435
+	"terse": 7,   // 3.50 guesses, branch 197
+	"testy": 47,  // 4.33 guesses, branch 197
+	"thank": 62,  // 4.00 guesses, branch 435
+	"theft": 17,  // 3.50 guesses, branch 197
+	"their": 9,   // 3.67 guesses, branch 122
+	"theme": 39,  // 4.17 guesses, branch 197
+	"there": 12,  // 3.67 guesses, branch 197
+	"these": 20,  // 3.83 guesses, branch 197
+	"theta": 69,  // 4.50 guesses, branch 435
+	"thick": 31,  // 3.50 guesses, branch 265
+	"thief": 46,  // 4.00 guesses, branch 265
+	"thigh": 54,  // 4.00 guesses, branch 265
+	"thing": 21,  // 3.33 guesses, branch 265
+	"think": 61,  // 4.17 guesses, branch 265
+	"third": 27,  // 4.17 guesses, branch 90
+	"thong": 53,  // 4.00 guesses, branch 282
+	"thorn": 30,  // 3.67 guesses, branch 282
+	"those": 25,  // 3.67 guesses, branch 282
+	"three": 30,  // 4.17 guesses, branch 190
+	"threw": 27,  // 3.83 guesses, branch 190
+	"throb": 26,  // 3.50 guesses, branch 282
+	"throw": 71,  // 4.50 guesses, branch 282
+	"thrum": 20,  // 3.50 guesses, branch 188
+	"thumb": 20,  // 3.17 guesses, branch 263
+	"thump": 50,  // 3.83 guesses, branch 263
+	"thyme": 49,  // 4.17 guesses, branch 197
+	"tiara": 2,   // 3.50 guesses, branch 60
+	"tibia": 26,  // 4.00 guesses, branch 134
+	"tidal": 18,  // 3.83 guesses, branch 134
+	"tiger": 46,  // 4.17 guesses, branch 265
+	"tight": 95,  // 6.00 guesses, branch 265
+	"tilde": 8,   // 3.67 guesses, branch 102
+	"timer": 85,  // 5.33 guesses, branch 265
+	"timid": 76,  // 4.67 guesses, branch 263
+	"tipsy": 26,  // 3.50 guesses, branch 265
+	"titan": 5,   // 3.83 guesses, branch 60
+	"tithe": 47,  // 4.17 guesses, branch 265
+	"title": 28,  // 3.83 guesses, branch 265
+	"toast": 55,  // 4.33 guesses, branch 284
+	"today": 27,  // 4.00 guesses, branch 134
+	"toddy": 82,  // 4.83 guesses, branch 263
+	"token": 29,  // 3.67 guesses, branch 282
+	"tonal": 9,   // 3.17 guesses, branch 284
+	"tonga": 23,  // 3.50 guesses, branch 284
+	"tonic": 47,  // 4.50 guesses, branch 114
+	"tooth": 39,  // 3.83 guesses, branch 282
+	"topaz": 84,  // 5.00 guesses, branch 284
+	"topic": 20,  // 3.83 guesses, branch 114
+	"torch": 31,  // 3.67 guesses, branch 282
+	"torso": 14,  // 3.67 guesses, branch 162
+	"torus": 25,  // 3.83 guesses, branch 188
+	"total": 73,  // 4.83 guesses, branch 284
+	"totem": 57,  // 4.33 guesses, branch 282
+	"touch": 34,  // 3.83 guesses, branch 188
+	"tough": 82,  // 4.83 guesses, branch 263
+	"towel": 71,  // 4.67 guesses, branch 282
+	"tower": 86,  // 5.33 guesses, branch 282
+	"toxic": 55,  // 4.50 guesses, branch 114
+	"toxin": 38,  // 4.17 guesses, branch 114
+	"trace": 11,  // 3.17 guesses, branch 435
+	"track": 42,  // 3.67 guesses, branch 435
+	"tract": 43,  // 3.83 guesses, branch 435
+	"trade": 1,   // 3.33 guesses, branch 54
+	"trail": 15,  // 4.33 guesses, branch 60
+	"train": 3,   // 3.67 guesses, branch 60
+	"trait": 15,  // 4.33 guesses, branch 60
+	"tramp": 76,  // 4.50 guesses, branch 435
+	"trash": 16,  // 3.17 guesses, branch 435
+	"trawl": 73,  // 4.50 guesses, branch 435
+	"tread": 2,   // 3.50 guesses, branch 54
+	"treat": 26,  // 3.67 guesses, branch 435
+	"trend": 10,  // 4.00 guesses, branch 72
+	"triad": 1,   // 3.17 guesses, branch 51
+	"trial": 2,   // 3.67 guesses, branch 44
+	"tribe": 12,  // 3.33 guesses, branch 265
+	"trice": 44,  // 4.17 guesses, branch 265
+	"trick": 32,  // 3.67 guesses, branch 265
+	"tried": 20,  // 4.33 guesses, branch 72
+	"tripe": 90,  // 5.67 guesses, branch 265
+	"trite": 63,  // 4.67 guesses, branch 265
+	"troll": 56,  // 4.33 guesses, branch 282
+	"troop": 10,  // 3.17 guesses, branch 282
+	"trope": 62,  // 4.50 guesses, branch 282
+	"trout": 74,  // 5.00 guesses, branch 188
+	"trove": 91,  // 5.67 guesses, branch 282
+	"truce": 8,   // 3.67 guesses, branch 109
+	"truck": 34,  // 3.83 guesses, branch 188
+	"truer": 14,  // 4.00 guesses, branch 109
+	"truly": 36,  // 4.00 guesses, branch 188
+	"trump": 58,  // 4.33 guesses, branch 188
+	"trunk": 56,  // 4.33 guesses, branch 188
+	"truss": 42,  // 4.17 guesses, branch 188
+	"trust": 13,  // 3.50 guesses, branch 188
+	"truth": 60,  // 4.50 guesses, branch 188
+	"tryst": 26,  // 3.83 guesses, branch 190
+	"tubal": 28,  // 4.00 guesses, branch 134
+	"tuber": 37,  // 4.33 guesses, branch 122
+	"tulip": 41,  // 3.83 guesses, branch 263
+	"tulle": 7,   // 3.67 guesses, branch 102
+	"tumor": 22,  // 3.67 guesses, branch 188
+	"tunic": 28,  // 4.17 guesses, branch 86
+	"turbo": 23,  // 3.67 guesses, branch 188
+	"tutor": 18,  // 3.67 guesses, branch 188
+	"twang": 69,  // 4.17 guesses, branch 435
+	"tweak": 64,  // 4.17 guesses, branch 435
+	"tweed": 45,  // 4.50 guesses, branch 127
+	"tweet": 65,  // 4.83 guesses, branch 190
+	"twice": 22,  // 3.50 guesses, branch 265
+	"twine": 66,  // 4.50 guesses, branch 265
+	"twirl": 54,  // 4.17 guesses, branch 265
+	"twist": 32,  // 3.67 guesses, branch 265
+	"twixt": 57,  // 4.00 guesses, branch 265
+	"tying": 52,  // 4.00 guesses, branch 265
+	"udder": 48,  // 4.33 guesses, branch 165
+	"ulcer": 18,  // 4.00 guesses, branch 109
+	"ultra": 6,   // 3.83 guesses, branch 65
+	"umbra": 40,  // 4.17 guesses, branch 136
+	"uncle": 21,  // 4.00 guesses, branch 109
+	"uncut": 34,  // 3.83 guesses, branch 188
+	"under": 22,  // 3.83 guesses, branch 165
+	"undid": 60,  // 4.17 guesses, branch 246
+	"undue": 58,  // 4.50 guesses, branch 165
+	"unfed": 73,  // 4.83 guesses, branch 165
+	"unfit": 17,  // 3.67 guesses, branch 113
+	"unify": 39,  // 3.67 guesses, branch 246
+	"union": 67,  // 4.50 guesses, branch 246
+	"unite": 5,   // 3.83 guesses, branch 52
+	"unity": 20,  // 3.83 guesses, branch 113
+	"unlit": 5,   // 3.33 guesses, branch 113
+	"unmet": 46,  // 4.50 guesses, branch 109
+	"unset": 4,   // 3.33 guesses, branch 109
+	"untie": 3,   // 3.67 guesses, branch 52
+	"until": 13,  // 3.67 guesses, branch 113
+	"unwed": 92,  // 5.83 guesses, branch 165
+	"unzip": 86,  // 5.00 guesses, branch 246
+	"upper": 83,  // 5.33 guesses, branch 165
+	"upset": 18,  // 3.83 guesses, branch 127
+	"urban": 21,  // 3.83 guesses, branch 136
+	"urine": 49,  // 4.50 guesses, branch 165
+	"usage": 6,   // 4.00 guesses, branch 44
+	"usher": 21,  // 4.00 guesses, branch 122
+	"using": 42,  // 3.83 guesses, branch 246
+	"usual": 20,  // 3.83 guesses, branch 134
+	"usurp": 40,  // 4.00 guesses, branch 188
+	"utile": 5,   // 3.50 guesses, branch 102
+	"utter": 47,  // 4.67 guesses, branch 122
+	"vague": 27,  // 4.50 guesses, branch 49
+	"valet": 44,  // 3.83 guesses, branch 435
+	"valid": 48,  // 4.33 guesses, branch 134
+	"valor": 84,  // 5.17 guesses, branch 284
+	"value": 7,   // 4.00 guesses, branch 49
+	"valve": 84,  // 4.83 guesses, branch 435
+	"vapid": 66,  // 4.67 guesses, branch 136
+	"vapor": 80,  // 4.83 guesses, branch 284
+	"vault": 96,  // 6.50 guesses, branch 134
+	"vaunt": 96,  // 7.00 guesses, branch 53
+	"vegan": 87,  // 5.00 guesses, branch 435
+	"venom": 65,  // 4.33 guesses, branch 282
+	"venue": 53,  // 4.50 guesses, branch 165
+	"verge": 48,  // 4.33 guesses, branch 197
+	"verse": 89,  // 5.83 guesses, branch 197
+	"verso": 49,  // 4.33 guesses, branch 197
+	"verve": 92,  // 6.00 guesses, branch 197
+	"vicar": 44,  // 4.33 guesses, branch 136
+	"video": 58,  // 4.50 guesses, branch 165
+	"vigil": 73,  // 4.50 guesses, branch 263
+	"vigor": 62,  // 4.33 guesses, branch 221
+	"villa": 35,  // 4.17 guesses, branch 134
+	"vinyl": 54,  // 4.00 guesses, branch 265
+	"viola": 27,  // 4.00 guesses, branch 134
+	"viper": 78,  // 4.83 guesses, branch 265
+	"viral": 39,  // 4.67 guesses, branch 67
+	"virus": 72,  // 5.17 guesses, branch 90
+	"visit": 49,  // 4.00 guesses, branch 263
+	"visor": 13,  // 3.67 guesses, branch 114
+	"vista": 44,  // 4.33 guesses, branch 134
+	"vital": 65,  // 4.83 guesses, branch 134
+	"vivid": 96,  // 6.00 guesses, branch 263
+	"vixen": 78,  // 4.67 guesses, branch 265
+	"vocal": 91,  // 5.50 guesses, branch 284
+	"vodka": 67,  // 4.67 guesses, branch 136
+	"vogue": 97,  // 6.50 guesses, branch 165
+	"voice": 50,  // 4.33 guesses, branch 165
+	"voila": 51,  // 4.50 guesses, branch 134
+	"vomit": 78,  // 4.67 guesses, branch 263
+	"voter": 83,  // 5.17 guesses, branch 282
+	"vouch": 94,  // 5.83 guesses, branch 221
+	"vowel": 51,  // 4.00 guesses, branch 282
+	"vying": 97,  // 6.17 guesses, branch 265
+	"wacky": 100, // 7.00 guesses, branch 435
+	"wafer": 95,  // 5.83 guesses, branch 435
+	"wager": 100, // 7.00 guesses, branch 435
+	"wagon": 89,  // 5.33 guesses, branch 284
+	"waist": 43,  // 4.33 guesses, branch 134
+	"waive": 57,  // 5.17 guesses, branch 49
+	"waltz": 76,  // 4.33 guesses, branch 435
+	"warty": 89,  // 5.17 guesses, branch 435
+	"waste": 94,  // 5.83 guesses, branch 435
+	"watch": 100, // 7.00 guesses, branch 435
+	"water": 99,  // 7.00 guesses, branch 435
+	"waver": 98,  // 6.50 guesses, branch 435
+	"waxen": 90,  // 5.17 guesses, branch 435
+	"weary": 52,  // 4.00 guesses, branch 435
+	"weave": 93,  // 5.67 guesses, branch 435
+	"wedge": 91,  // 5.83 guesses, branch 165
+	"weedy": 74,  // 5.00 guesses, branch 165
+	"weigh": 77,  // 4.67 guesses, branch 265
+	"weird": 40,  // 4.17 guesses, branch 165
+	"welch": 97,  // 6.50 guesses, branch 197
+	"welsh": 62,  // 4.50 guesses, branch 197
+	"wench": 96,  // 6.33 guesses, branch 197
+	"whack": 88,  // 4.83 guesses, branch 435
+	"whale": 63,  // 4.17 guesses, branch 435
+	"wharf": 88,  // 5.00 guesses, branch 435
+	"wheat": 83,  // 4.83 guesses, branch 435
+	"wheel": 74,  // 5.00 guesses, branch 190
+	"whelp": 81,  // 5.00 guesses, branch 197
+	"where": 61,  // 4.67 guesses, branch 197
+	"which": 71,  // 4.33 guesses, branch 265
+	"whiff": 83,  // 4.67 guesses, branch 265
+	"while": 85,  // 5.17 guesses, branch 265
+	"whine": 79,  // 4.83 guesses, branch 265
+	"whiny": 70,  // 4.33 guesses, branch 265
+	"whirl": 76,  // 4.67 guesses, branch 265
+	"whisk": 52,  // 3.83 guesses, branch 265
+	"white": 82,  // 5.00 guesses, branch 265
+	"whole": 83,  // 5.00 guesses, branch 282
+	"whoop": 83,  // 4.83 guesses, branch 282
+	"whose": 63,  // 4.33 guesses, branch 282
+	"widen": 44,  // 4.17 guesses, branch 165
+	"wider": 98,  // 7.00 guesses, branch 165
+	"widow": 59,  // 4.00 guesses, branch 263
+	"width": 69,  // 4.33 guesses, branch 263
+	"wield": 59,  // 4.67 guesses, branch 127
+	"wight": 100, // 7.00 guesses, branch 265
+	"willy": 99,  // 7.00 guesses, branch 265
+	"wimpy": 93,  // 5.50 guesses, branch 265
+	"wince": 85,  // 5.17 guesses, branch 265
+	"winch": 99,  // 6.83 guesses, branch 265
+	"windy": 86,  // 5.00 guesses, branch 246
+	"wiser": 75,  // 4.83 guesses, branch 265
+	"wispy": 69,  // 4.33 guesses, branch 265
+	"witch": 98,  // 6.50 guesses, branch 265
+	"witty": 97,  // 6.33 guesses, branch 265
+	"woken": 71,  // 4.50 guesses, branch 282
+	"woman": 78,  // 4.67 guesses, branch 284
+	"women": 43,  // 3.83 guesses, branch 282
+	"woody": 98,  // 6.50 guesses, branch 263
+	"wooer": 90,  // 5.67 guesses, branch 282
+	"wooly": 95,  // 6.00 guesses, branch 282
+	"woozy": 93,  // 5.50 guesses, branch 282
+	"wordy": 69,  // 4.50 guesses, branch 221
+	"world": 48,  // 4.50 guesses, branch 99
+	"worry": 92,  // 5.67 guesses, branch 282
+	"worse": 96,  // 6.33 guesses, branch 282
+	"worst": 62,  // 4.33 guesses, branch 282
+	"worth": 87,  // 5.17 guesses, branch 282
+	"would": 74,  // 4.50 guesses, branch 263
+	"wound": 92,  // 5.50 guesses, branch 246
+	"woven": 93,  // 5.67 guesses, branch 282
+	"wrack": 99,  // 6.67 guesses, branch 435
+	"wrath": 81,  // 4.67 guesses, branch 435
+	"wreak": 98,  // 6.67 guesses, branch 435
+	"wreck": 50,  // 4.17 guesses, branch 197
+	"wrest": 88,  // 5.67 guesses, branch 197
+	"wring": 94,  // 5.83 guesses, branch 265
+	"wrist": 73,  // 4.67 guesses, branch 265
+	"write": 79,  // 5.00 guesses, branch 265
+	"wrong": 67,  // 4.33 guesses, branch 282
+	"wrote": 40,  // 4.00 guesses, branch 282
+	"wrung": 80,  // 4.83 guesses, branch 221
+	"wryly": 71,  // 4.67 guesses, branch 190
+	"yacht": 61,  // 4.00 guesses, branch 435
+	"yearn": 62,  // 4.33 guesses, branch 435
+	"yeast": 97,  // 6.33 guesses, branch 435
+	"yield": 90,  // 6.00 guesses, branch 127
+	"young": 66,  // 4.33 guesses, branch 246
+	"youth": 35,  // 3.67 guesses, branch 263
+	"zebra": 75,  // 4.50 guesses, branch 435
+	"zesty": 98,  // 6.67 guesses, branch 197
+	"zonal": 87,  // 5.17 guesses, branch 284
+}
@@ -0,0 +1,12 @@
+package data
+
+import (
+	_ "embed"
+	"strings"
+)
+
+//go:embed words.txt
+var commonWords string
+
+// CommonWords5 are five letter english words, most common first.
+var CommonWords5 = strings.Fields(commonWords)
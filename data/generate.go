@@ -0,0 +1,4 @@
+package data
+
+//go:generate go run .. analyze -ratings difficulty5.go
+//go:generate gzip -9nkf definitions.tsv
@@ -0,0 +1,204 @@
+package data
+
+var GermanWords5 = []string{
+	"abend",
+	"acker",
+	"adler",
+	"affen",
+	"angst",
+	"apfel",
+	"asche",
+	"atmen",
+	"bauch",
+	"bauer",
+	"beere",
+	"besen",
+	"birne",
+	"blatt",
+	"blick",
+	"blitz",
+	"blume",
+	"boden",
+	"brett",
+	"brief",
+	"bruch",
+	"brust",
+	"buche",
+	"bühne",
+	"dampf",
+	"decke",
+	"dicht",
+	"draht",
+	"drama",
+	"dreck",
+	"durst",
+	"eimer",
+	"eisen",
+	"engel",
+	"ernte",
+	"essen",
+	"fahne",
+	"farbe",
+	"feder",
+	"feind",
+	"ferne",
+	"fisch",
+	"fluss",
+	"forst",
+	"frage",
+	"frost",
+	"fuchs",
+	"gabel",
+	"gasse",
+	"geist",
+	"glanz",
+	"glück",
+	"gnade",
+	"grund",
+	"gurke",
+	"hafen",
+	"hagel",
+	"hasen",
+	"haupt",
+	"hecke",
+	"heide",
+	"hitze",
+	"hobel",
+	"honig",
+	"hotel",
+	"hunde",
+	"hügel",
+	"hütte",
+	"insel",
+	"jacke",
+	"jagen",
+	"kabel",
+	"kampf",
+	"kanne",
+	"kasse",
+	"katze",
+	"kerze",
+	"kette",
+	"kiste",
+	"klang",
+	"klein",
+	"knopf",
+	"kohle",
+	"kraft",
+	"kranz",
+	"kreis",
+	"krieg",
+	"krone",
+	"kunst",
+	"kurve",
+	"küche",
+	"lampe",
+	"leben",
+	"leder",
+	"lehre",
+	"leise",
+	"licht",
+	"liebe",
+	"lippe",
+	"löwen",
+	"lücke",
+	"mauer",
+	"meter",
+	"milch",
+	"minze",
+	"mitte",
+	"monat",
+	"motor",
+	"musik",
+	"mühle",
+	"mütze",
+	"nacht",
+	"nadel",
+	"nebel",
+	"nudel",
+	"nähen",
+	"onkel",
+	"opfer",
+	"paket",
+	"papst",
+	"perle",
+	"pferd",
+	"pflug",
+	"platz",
+	"preis",
+	"probe",
+	"punkt",
+	"qualm",
+	"quark",
+	"rasen",
+	"regen",
+	"reich",
+	"reise",
+	"rubin",
+	"rufen",
+	"ruhig",
+	"sache",
+	"sahne",
+	"salat",
+	"samen",
+	"sauer",
+	"schaf",
+	"seele",
+	"segel",
+	"seife",
+	"sonne",
+	"speck",
+	"spiel",
+	"sport",
+	"stadt",
+	"stahl",
+	"stein",
+	"stern",
+	"stirn",
+	"stock",
+	"stolz",
+	"stuhl",
+	"sturm",
+	"summe",
+	"suppe",
+	"süden",
+	"tafel",
+	"tanne",
+	"tante",
+	"tasse",
+	"taube",
+	"teich",
+	"thema",
+	"tiger",
+	"tisch",
+	"traum",
+	"treue",
+	"trost",
+	"tulpe",
+	"türen",
+	"uhren",
+	"vogel",
+	"waffe",
+	"wagen",
+	"wange",
+	"weide",
+	"wiese",
+	"wolke",
+	"wolle",
+	"wunde",
+	"wurst",
+	"wärme",
+	"würde",
+	"zange",
+	"zebra",
+	"zeile",
+	"zelle",
+	"ziege",
+	"zucht",
+	"zunge",
+	"zweig",
+	"äpfel",
+	"ärger",
+	"ärzte",
+	"übung",
+}
@@ -0,0 +1,298 @@
+package data
+
+var SpanishWords5 = []string{
+	"abajo",
+	"abeja",
+	"abril",
+	"abrir",
+	"acero",
+	"actor",
+	"adios",
+	"agudo",
+	"ahora",
+	"alamo",
+	"album",
+	"aldea",
+	"altar",
+	"altos",
+	"amigo",
+	"ancho",
+	"angel",
+	"animo",
+	"antes",
+	"apoyo",
+	"arbol",
+	"arena",
+	"arroz",
+	"asado",
+	"atlas",
+	"avion",
+	"ayuda",
+	"bahia",
+	"bajar",
+	"banco",
+	"barco",
+	"baños",
+	"bello",
+	"besar",
+	"bicho",
+	"bolsa",
+	"bravo",
+	"brazo",
+	"breve",
+	"broma",
+	"bueno",
+	"burro",
+	"cable",
+	"cabra",
+	"caida",
+	"calle",
+	"calor",
+	"campo",
+	"canto",
+	"carne",
+	"carta",
+	"casco",
+	"causa",
+	"cazar",
+	"cerca",
+	"cerdo",
+	"cielo",
+	"cinco",
+	"circo",
+	"clase",
+	"clave",
+	"cobre",
+	"coche",
+	"comer",
+	"corto",
+	"costa",
+	"crema",
+	"cruce",
+	"cueva",
+	"culpa",
+	"curso",
+	"dados",
+	"danza",
+	"daños",
+	"debil",
+	"decir",
+	"dedos",
+	"dejar",
+	"dueño",
+	"dulce",
+	"duque",
+	"durar",
+	"error",
+	"etapa",
+	"falda",
+	"falso",
+	"fecha",
+	"feliz",
+	"fiera",
+	"firma",
+	"flaco",
+	"forma",
+	"fruta",
+	"fuego",
+	"fuera",
+	"gafas",
+	"gallo",
+	"ganar",
+	"gasto",
+	"gente",
+	"globo",
+	"golpe",
+	"gordo",
+	"gorra",
+	"grado",
+	"gramo",
+	"grano",
+	"grave",
+	"grito",
+	"grupo",
+	"guapo",
+	"hacer",
+	"hacha",
+	"harto",
+	"hielo",
+	"hijos",
+	"hogar",
+	"hongo",
+	"hotel",
+	"huevo",
+	"humor",
+	"igual",
+	"jamon",
+	"jarra",
+	"joven",
+	"joyas",
+	"juego",
+	"jugar",
+	"julio",
+	"junio",
+	"justo",
+	"labio",
+	"lapiz",
+	"largo",
+	"leche",
+	"lecho",
+	"lento",
+	"letra",
+	"libro",
+	"lider",
+	"limon",
+	"listo",
+	"llave",
+	"lleno",
+	"lobos",
+	"local",
+	"luces",
+	"lucha",
+	"lunes",
+	"madre",
+	"mango",
+	"marzo",
+	"mayor",
+	"media",
+	"mejor",
+	"menor",
+	"mente",
+	"metro",
+	"miedo",
+	"mismo",
+	"monte",
+	"morir",
+	"motor",
+	"mover",
+	"mucho",
+	"mujer",
+	"mundo",
+	"museo",
+	"nadar",
+	"nariz",
+	"negro",
+	"nieve",
+	"niñas",
+	"niños",
+	"noche",
+	"norte",
+	"novia",
+	"nubes",
+	"nuevo",
+	"nunca",
+	"obras",
+	"ocaso",
+	"oeste",
+	"oliva",
+	"orden",
+	"oreja",
+	"otoño",
+	"padre",
+	"pagar",
+	"pared",
+	"parte",
+	"pasta",
+	"patio",
+	"pañal",
+	"pecho",
+	"pedir",
+	"perro",
+	"pesca",
+	"piano",
+	"pieza",
+	"pinta",
+	"pista",
+	"piñas",
+	"placa",
+	"plata",
+	"playa",
+	"plaza",
+	"plomo",
+	"pluma",
+	"pobre",
+	"poder",
+	"poema",
+	"poeta",
+	"pollo",
+	"polvo",
+	"prado",
+	"prisa",
+	"punto",
+	"queso",
+	"quien",
+	"radio",
+	"rampa",
+	"rango",
+	"rayos",
+	"razon",
+	"reina",
+	"reloj",
+	"resto",
+	"risas",
+	"ritmo",
+	"rival",
+	"robot",
+	"rodar",
+	"rojos",
+	"rubio",
+	"rueda",
+	"ruido",
+	"rumbo",
+	"saber",
+	"sabio",
+	"salir",
+	"salsa",
+	"salud",
+	"santo",
+	"selva",
+	"senda",
+	"serie",
+	"señal",
+	"señor",
+	"siglo",
+	"silla",
+	"sobre",
+	"solar",
+	"sonar",
+	"suave",
+	"sucio",
+	"suelo",
+	"sueño",
+	"tabla",
+	"tarde",
+	"tarea",
+	"techo",
+	"tecla",
+	"temer",
+	"tener",
+	"texto",
+	"tigre",
+	"tinta",
+	"tirar",
+	"todos",
+	"tomar",
+	"torre",
+	"total",
+	"traje",
+	"trigo",
+	"turno",
+	"union",
+	"usted",
+	"vacas",
+	"valle",
+	"vapor",
+	"vasos",
+	"vejez",
+	"velas",
+	"venta",
+	"verde",
+	"viaje",
+	"viejo",
+	"vivir",
+	"volar",
+	"yerno",
+	"zorro",
+	"zumos",
+	"ñandu",
+}
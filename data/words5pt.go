@@ -0,0 +1,222 @@
+package data
+
+var PortugueseWords5 = []string{
+	"abrir",
+	"achar",
+	"acido",
+	"agora",
+	"aluno",
+	"amigo",
+	"amora",
+	"andar",
+	"anjos",
+	"antes",
+	"apoio",
+	"areia",
+	"arroz",
+	"assim",
+	"atlas",
+	"aviao",
+	"azedo",
+	"baixo",
+	"balde",
+	"banco",
+	"barco",
+	"beijo",
+	"bicho",
+	"bolsa",
+	"bomba",
+	"brisa",
+	"bruxa",
+	"cabra",
+	"caixa",
+	"calor",
+	"campo",
+	"canto",
+	"carne",
+	"carta",
+	"casal",
+	"causa",
+	"certo",
+	"chave",
+	"cheio",
+	"chuva",
+	"cinco",
+	"cinza",
+	"circo",
+	"claro",
+	"cobra",
+	"coisa",
+	"comer",
+	"corpo",
+	"corte",
+	"costa",
+	"couro",
+	"crise",
+	"dados",
+	"dente",
+	"deusa",
+	"dizer",
+	"doces",
+	"dolar",
+	"dores",
+	"drama",
+	"duplo",
+	"enfim",
+	"entre",
+	"errar",
+	"etapa",
+	"falar",
+	"falso",
+	"farol",
+	"feliz",
+	"festa",
+	"ficar",
+	"filho",
+	"fogao",
+	"folha",
+	"fonte",
+	"forca",
+	"forma",
+	"forte",
+	"fraco",
+	"frase",
+	"fruta",
+	"fundo",
+	"gaita",
+	"galho",
+	"ganso",
+	"garfo",
+	"gasto",
+	"genio",
+	"gente",
+	"girar",
+	"gordo",
+	"gosto",
+	"grama",
+	"grato",
+	"grupo",
+	"haver",
+	"honra",
+	"hotel",
+	"idade",
+	"igual",
+	"ilhas",
+	"irmao",
+	"jeito",
+	"jogar",
+	"jovem",
+	"juizo",
+	"julho",
+	"junho",
+	"justo",
+	"lapis",
+	"largo",
+	"leite",
+	"lenda",
+	"lento",
+	"letra",
+	"limao",
+	"linha",
+	"livro",
+	"lugar",
+	"luzes",
+	"macio",
+	"maior",
+	"manga",
+	"manha",
+	"massa",
+	"meias",
+	"menor",
+	"mente",
+	"mesmo",
+	"metro",
+	"miolo",
+	"morte",
+	"motor",
+	"mudar",
+	"mundo",
+	"museu",
+	"nadar",
+	"navio",
+	"negro",
+	"noite",
+	"norte",
+	"nuvem",
+	"obras",
+	"ontem",
+	"ordem",
+	"outro",
+	"padre",
+	"pagar",
+	"palco",
+	"papel",
+	"parte",
+	"passo",
+	"pasta",
+	"patio",
+	"pedra",
+	"peito",
+	"peixe",
+	"perna",
+	"piano",
+	"plano",
+	"poder",
+	"ponte",
+	"porco",
+	"porta",
+	"praia",
+	"prato",
+	"prazo",
+	"preco",
+	"prova",
+	"quase",
+	"queda",
+	"quero",
+	"radio",
+	"raiva",
+	"razao",
+	"regra",
+	"reino",
+	"risco",
+	"roupa",
+	"ruido",
+	"sabao",
+	"saber",
+	"saida",
+	"salto",
+	"samba",
+	"santo",
+	"saude",
+	"selva",
+	"senha",
+	"serra",
+	"sinal",
+	"sobre",
+	"sonho",
+	"sorte",
+	"suave",
+	"sujar",
+	"tarde",
+	"tecla",
+	"tempo",
+	"terra",
+	"texto",
+	"tigre",
+	"tinta",
+	"trigo",
+	"troca",
+	"turma",
+	"uniao",
+	"vacas",
+	"valor",
+	"vapor",
+	"velho",
+	"venda",
+	"verde",
+	"vidro",
+	"vinho",
+	"viver",
+	"volta",
+	"zebra",
+}
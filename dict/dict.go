@@ -0,0 +1,68 @@
+package dict
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"sync"
+
+	"koutaroyumiba/wordle/data"
+)
+
+// Entry is what the pack says about a word.
+type Entry struct {
+	Word       string
+	Definition string
+	// the language the word comes from, empty when unknown
+	Origin string
+}
+
+func (e Entry) String() string {
+	if e.Origin == "" {
+		return e.Definition
+	}
+	return e.Definition + " (from " + e.Origin + ")"
+}
+
+var (
+	loadOnce sync.Once
+	entries  map[string]Entry
+)
+
+// Lookup finds the definition of word. the pack is only unpacked on the
+// first lookup so starting a game doesn't pay for it.
+func Lookup(word string) (Entry, bool) {
+	loadOnce.Do(func() {
+		entries = load(data.DefinitionsGz)
+	})
+
+	e, ok := entries[strings.ToLower(word)]
+	return e, ok
+}
+
+// load parses the pack, a broken pack gives no definitions rather than an
+// error since they are only a nicety.
+func load(compressed []byte) map[string]Entry {
+	out := map[string]Entry{}
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return out
+	}
+	defer r.Close()
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) < 2 || fields[0] == "" {
+			continue
+		}
+		e := Entry{Word: fields[0], Definition: fields[1]}
+		if len(fields) > 2 {
+			e.Origin = fields[2]
+		}
+		out[e.Word] = e
+	}
+
+	return out
+}
@@ -0,0 +1,152 @@
+package drill
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+
+	"koutaroyumiba/wordle/bot"
+	"koutaroyumiba/wordle/game"
+)
+
+const (
+	// list every answer that still fits the rows
+	TypeCandidates = "candidates"
+	// play the guess that tells the most
+	TypeBestGuess = "best-guess"
+)
+
+// Types are the kinds of drill, each with its own progress.
+var Types = []string{TypeCandidates, TypeBestGuess}
+
+// answers left after the rows of a drill, few enough to list all of them
+// or enough to make the choice of guess matter
+var candidateRange = map[string][2]int{
+	TypeCandidates: {2, 12},
+	TypeBestGuess:  {4, 40},
+}
+
+const (
+	maxRows = 4
+	// games generated before settling for one outside the range
+	maxTries = 200
+)
+
+// Drill is a partial game to practise on.
+type Drill struct {
+	Type   string
+	Answer string
+	// the guesses played so far with their feedback
+	Rows [][]game.Cell
+	// the answers that still fit the rows
+	Candidates []string
+}
+
+// Result is how well a drill was answered.
+type Result struct {
+	// 0 to 100
+	Score int
+	// candidates drills: the answers left out and the words listed that
+	// don't fit
+	Missed, Wrong []string
+	// best guess drills: the entropy of the guess against the bot's best,
+	// and where the guess is in the bot's ranking of every word
+	Entropy, BestEntropy float64
+	Best                 string
+	Rank, Ranked         int
+}
+
+// New generates a drill of a type: random guesses are played against a
+// random answer until the answers left are in the drill's range.
+func New(kind string, answers []string, wordLength, maxGuesses int) (Drill, error) {
+	want, ok := candidateRange[kind]
+	if !ok {
+		return Drill{}, fmt.Errorf("drill type must be one of %s (got %q)", strings.Join(Types, ", "), kind)
+	}
+	// one answer can't be told apart from anything, and the guesses would
+	// never differ from it
+	if len(answers) < 2 {
+		return Drill{}, errors.New("drills need at least two answers")
+	}
+
+	answerBot := bot.InitBotWithWords(wordLength, maxGuesses, answers)
+	rows := min(maxRows, maxGuesses-1)
+
+	var d Drill
+	for range maxTries {
+		d = Drill{Type: kind, Answer: answers[rand.Intn(len(answers))]}
+		d.Candidates = answers
+		for len(d.Rows) < rows && len(d.Candidates) > want[1] {
+			guess := []rune(answers[rand.Intn(len(answers))])
+			if string(guess) == d.Answer {
+				continue
+			}
+
+			states := game.EvaluateGuess([]rune(d.Answer), guess)
+			row := make([]game.Cell, len(guess))
+			for i, char := range guess {
+				row[i] = game.NewCell(char, states[i])
+			}
+			d.Rows = append(d.Rows, row)
+			_, left := answerBot.Analysis(d.Rows)
+			d.Candidates = left[len(d.Rows)]
+		}
+		if len(d.Candidates) >= want[0] && len(d.Candidates) <= want[1] {
+			break
+		}
+	}
+
+	return d, nil
+}
+
+// ScoreCandidates marks a list of the answers left: the share of the right
+// ones found, with every wrong word counting against it like a missed one.
+func (d Drill) ScoreCandidates(listed []string) Result {
+	var r Result
+	found := 0
+	for _, word := range listed {
+		if slices.Contains(d.Candidates, word) {
+			found++
+		} else {
+			r.Wrong = append(r.Wrong, word)
+		}
+	}
+	for _, word := range d.Candidates {
+		if !slices.Contains(listed, word) {
+			r.Missed = append(r.Missed, word)
+		}
+	}
+
+	r.Score = int(math.Round(100 * float64(found) / float64(len(d.Candidates)+len(r.Wrong))))
+	return r
+}
+
+// ScoreGuess marks a guess by the information it gives compared to the
+// best guess in the bot's ranking.
+func (d Drill) ScoreGuess(guess string, ranking []bot.GuessScore) Result {
+	r := Result{Ranked: len(ranking)}
+	if len(ranking) == 0 {
+		return r
+	}
+	r.Best, r.BestEntropy = ranking[0].Word, ranking[0].Entropy
+
+	r.Rank = len(ranking)
+	for i, g := range ranking {
+		if g.Word == guess {
+			r.Rank, r.Entropy = i+1, g.Entropy
+			break
+		}
+	}
+	// ties share the best rank
+	for r.Rank > 1 && ranking[r.Rank-2].Entropy == r.Entropy {
+		r.Rank--
+	}
+
+	if r.BestEntropy > 0 {
+		r.Score = int(math.Round(100 * r.Entropy / r.BestEntropy))
+	}
+	return r
+}
@@ -0,0 +1,69 @@
+package drill
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+)
+
+// scores kept per drill type for the trend
+const recentScores = 20
+
+// Record is how a player has done on one type of drill.
+type Record struct {
+	Played     int `json:"played"`
+	TotalScore int `json:"total_score"`
+	Best       int `json:"best"`
+	// the last scores, oldest first
+	Recent []int `json:"recent,omitempty"`
+}
+
+func (r Record) Average() float64 {
+	if r.Played == 0 {
+		return 0
+	}
+	return float64(r.TotalScore) / float64(r.Played)
+}
+
+// Progress is a Record per drill type.
+type Progress map[string]Record
+
+// LoadProgress reads the progress saved at path, a missing file is no
+// progress yet.
+func LoadProgress(path string) (Progress, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Progress{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p := Progress{}
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p Progress) Save(path string) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// Add counts a drill's score.
+func (p Progress) Add(kind string, score int) {
+	r := p[kind]
+	r.Played++
+	r.TotalScore += score
+	r.Best = max(r.Best, score)
+	r.Recent = append(r.Recent, score)
+	if len(r.Recent) > recentScores {
+		r.Recent = r.Recent[len(r.Recent)-recentScores:]
+	}
+	p[kind] = r
+}
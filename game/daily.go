@@ -0,0 +1,58 @@
+package game
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+)
+
+// everyone plays the daily puzzle with the same board, whatever their own
+// settings
+const (
+	DailyWordLength = 5
+	DailyMaxGuesses = 6
+)
+
+// the first daily puzzle, the day the original game's numbering starts
+var firstDaily = time.Date(2021, time.June, 19, 0, 0, 0, 0, time.UTC)
+
+// DailyNumber is the number of the daily puzzle on t's calendar day, so
+// everyone on the same date gets the same puzzle wherever they are.
+func DailyNumber(t time.Time) int {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return int(day.Sub(firstDaily).Hours() / 24)
+}
+
+// DailyAnswer is the answer of daily puzzle number n. it only depends on n
+// and the answer list, so every client agrees on it without a server.
+func DailyAnswer(answers []string, n int) string {
+	if len(answers) == 0 {
+		return pickRandomWord(answers)
+	}
+
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(n))
+	sum := sha256.Sum256(append([]byte("daily:"), b[:]...))
+
+	return answers[binary.BigEndian.Uint64(sum[:8])%uint64(len(answers))]
+}
+
+// PlayedDaily reports whether the history has a game of daily puzzle n.
+func PlayedDaily(history []GameRecord, n int) bool {
+	for _, r := range history {
+		if r.Daily == n {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetDaily marks the game as daily puzzle number n.
+func (g *GameState) SetDaily(n int) {
+	g.daily = n
+}
+
+func (g GameState) GetDaily() int {
+	return g.daily
+}
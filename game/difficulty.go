@@ -0,0 +1,65 @@
+package game
+
+import "koutaroyumiba/wordle/data"
+
+const (
+	TierAny    = "any"
+	TierEasy   = "easy"
+	TierMedium = "medium"
+	TierHard   = "hard"
+	TierBrutal = "brutal"
+)
+
+// Tiers are the difficulty tiers from easiest to hardest.
+var Tiers = []string{TierEasy, TierMedium, TierHard, TierBrutal}
+
+// AnswerRating is the generated 0..100 difficulty of an answer, only the
+// five letter english answers are rated.
+func AnswerRating(answer string) (int, bool) {
+	score, ok := data.AnswerDifficulty5[answer]
+	return score, ok
+}
+
+// TierOf puts a rating in one of the Tiers, the hardest tenth of the
+// answers being brutal.
+func TierOf(score int) string {
+	switch {
+	case score < 30:
+		return TierEasy
+	case score < 60:
+		return TierMedium
+	case score < 90:
+		return TierHard
+	default:
+		return TierBrutal
+	}
+}
+
+// RatingDifficulty rates answers by their generated rating, unrated ones
+// count as medium.
+func RatingDifficulty(answer string) float64 {
+	if score, ok := AnswerRating(answer); ok {
+		return float64(score)
+	}
+	return 50
+}
+
+// answersInTier keeps the rated answers of a tier, all of them for
+// TierAny or when none of them are rated that way.
+func answersInTier(answers []string, tier string) []string {
+	if tier == "" || tier == TierAny {
+		return answers
+	}
+
+	inTier := []string{}
+	for _, answer := range answers {
+		if score, ok := AnswerRating(answer); ok && TierOf(score) == tier {
+			inTier = append(inTier, answer)
+		}
+	}
+	if len(inTier) == 0 {
+		return answers
+	}
+
+	return inTier
+}
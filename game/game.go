@@ -3,10 +3,11 @@ package game
 import (
 	"fmt"
 	"math/rand"
-	"slices"
 	"time"
+	"unicode/utf8"
 
 	"koutaroyumiba/wordle/data"
+	"koutaroyumiba/wordle/wordindex"
 )
 
 type CellState int
@@ -28,6 +29,12 @@ type Cell struct {
 	state CellState
 }
 
+// NewCell is a guessed letter with its feedback, e.g. to describe a game
+// played somewhere else to the bot.
+func NewCell(char rune, state CellState) Cell {
+	return Cell{char: char, state: state}
+}
+
 func (c Cell) GetInfo() (rune, CellState) {
 	return c.char, c.state
 }
@@ -35,29 +42,51 @@ func (c Cell) GetInfo() (rune, CellState) {
 type GameState struct {
 	stats           Stats
 	answer          string
+	dictionary      *wordindex.Index
 	guessesResults  [][]Cell
 	knownLetters    map[rune]CellState
+	knowledge       Knowledge
 	wordLength      int
 	maxGuesses      int
 	allowDictionary bool
+	hardMode        bool
 	currentRow      int
 	finished        bool
+	recordStats     bool
+	assisted        bool
+	// daily puzzle number, 0 for other games
+	daily   int
+	started time.Time
+	// when each guess was made, for replays
+	guessTimes []time.Duration
+	evaluator  Evaluator
 }
 
 func InitGame(wordLength, maxGuesses int) GameState {
-	secret := pickRandomWord(validAnswers)
+	return InitGameWithWords(wordLength, maxGuesses, validAnswers, dictionary)
+}
+
+// InitGameWithWords picks the answer from answers and only accepts guesses
+// found in words, e.g. for another language.
+func InitGameWithWords(wordLength, maxGuesses int, answers, words []string) GameState {
+	secret := pickRandomWord(answers)
 	board := initialiseEmptyBoard(wordLength, maxGuesses)
 
 	return GameState{
 		stats:           loadStats(),
 		answer:          secret,
+		dictionary:      wordindex.Shared(words, wordLength),
 		guessesResults:  board,
 		knownLetters:    make(map[rune]CellState),
+		knowledge:       NewKnowledge(wordLength),
 		wordLength:      wordLength,
 		maxGuesses:      maxGuesses,
 		allowDictionary: true,
 		currentRow:      0,
 		finished:        false,
+		recordStats:     true,
+		started:         time.Now(),
+		evaluator:       Standard,
 	}
 }
 
@@ -80,13 +109,18 @@ func InitGameWithWord(wordLength, maxGuesses int, correctWord string) GameState
 	return GameState{
 		stats:           loadStats(),
 		answer:          correctWord,
+		dictionary:      wordindex.Shared(dictionary, wordLength),
 		guessesResults:  board,
 		knownLetters:    make(map[rune]CellState),
+		knowledge:       NewKnowledge(wordLength),
 		wordLength:      wordLength,
 		maxGuesses:      maxGuesses,
 		allowDictionary: true,
 		currentRow:      0,
 		finished:        false,
+		recordStats:     true,
+		started:         time.Now(),
+		evaluator:       Standard,
 	}
 }
 
@@ -104,24 +138,33 @@ func initialiseEmptyBoard(wordLength, maxGuesses int) [][]Cell {
 }
 
 func (g GameState) ValidateWord(word string) (bool, string) {
-	if len(word) != g.wordLength {
+	if utf8.RuneCountInString(word) != g.wordLength {
 		return false, fmt.Sprintf("guess must be %d letters", g.wordLength)
 	}
 
-	if g.allowDictionary && !slices.Contains(dictionary, word) {
+	if g.allowDictionary && !g.dictionary.Contains(word) {
 		return false, "not in word list"
 	}
 
+	if g.hardMode {
+		return g.knowledge.checkHardMode([]rune(word))
+	}
+
 	return true, ""
 }
 
 func (g *GameState) ApplyGuess(guess string) (bool, bool) {
-	guessResult := EvaluateGuess([]rune(g.answer), []rune(guess))
-	g.updateKnownLetter(guess, guessResult)
+	guessResult := g.evaluator.Evaluate([]rune(g.answer), []rune(guess))
+	// the keyboard and hard mode only go by feedback that can be trusted
+	if g.evaluator == Standard {
+		g.updateKnownLetter(guess, guessResult)
+		g.knowledge.Add([]rune(guess), guessResult)
+	}
 	g.updateState(guess, guessResult)
+	g.guessTimes = append(g.guessTimes, time.Since(g.started))
 
 	won := false
-	if isCorrectGuess(guessResult) {
+	if guess == g.answer {
 		g.finished = true
 		won = true
 
@@ -138,14 +181,20 @@ func (g *GameState) ApplyGuess(guess string) (bool, bool) {
 		} else {
 			g.stats.GuessFrequency[g.currentRow] = 1
 		}
+		g.stats.History = append(g.stats.History, g.record(true))
 
-		saveStats(g.stats)
+		if g.recordStats {
+			saveStats(g.stats)
+		}
 	} else if g.currentRow >= g.maxGuesses {
 		g.finished = true
 		g.stats.GamesPlayed++
 		g.stats.CurrentStreak = 0
+		g.stats.History = append(g.stats.History, g.record(false))
 
-		saveStats(g.stats)
+		if g.recordStats {
+			saveStats(g.stats)
+		}
 	}
 
 	return g.finished, won
@@ -181,19 +230,10 @@ func EvaluateGuess(answer, guess []rune) []CellState {
 	return result
 }
 
-func isCorrectGuess(guess []CellState) bool {
-	for _, s := range guess {
-		if s != StateCorrect {
-			return false
-		}
-	}
-
-	return true
-}
-
 func (g *GameState) updateKnownLetter(guess string, states []CellState) {
+	runes := []rune(guess)
 	for i := range g.wordLength {
-		char := rune(guess[i])
+		char := runes[i]
 		prev, ok := g.knownLetters[char]
 		if !ok || states[i] < prev {
 			g.knownLetters[char] = states[i]
@@ -202,8 +242,9 @@ func (g *GameState) updateKnownLetter(guess string, states []CellState) {
 }
 
 func (g *GameState) updateState(guess string, states []CellState) {
+	runes := []rune(guess)
 	for i := range g.wordLength {
-		g.guessesResults[g.currentRow][i].char = rune(guess[i])
+		g.guessesResults[g.currentRow][i].char = runes[i]
 		g.guessesResults[g.currentRow][i].state = states[i]
 	}
 
@@ -227,6 +268,49 @@ func (g GameState) GetCurrentBoardRow(currentWord []rune, index int) []Cell {
 	return line
 }
 
+// SetDictionary replaces the list of words ValidateWord accepts.
+func (g *GameState) SetDictionary(words []string) {
+	g.dictionary = wordindex.Shared(words, g.wordLength)
+}
+
+// SetAllowDictionary turns the word list check in ValidateWord on or off.
+func (g *GameState) SetAllowDictionary(allow bool) {
+	g.allowDictionary = allow
+}
+
+// SetHardMode makes ValidateWord insist that every guess keeps the greens
+// in place and uses every letter found so far.
+func (g *GameState) SetHardMode(hard bool) {
+	g.hardMode = hard
+}
+
+// SetEvaluator plays the game with other feedback rules.
+func (g *GameState) SetEvaluator(ev Evaluator) {
+	g.evaluator = ev
+}
+
+func (g GameState) GetEvaluator() Evaluator {
+	return g.evaluator
+}
+
+// DisableStats stops the game from writing its result to the stats file,
+// e.g. when an external bot is playing.
+func (g *GameState) DisableStats() {
+	g.recordStats = false
+}
+
+func (g GameState) GetAttempts() int {
+	return g.currentRow
+}
+
+func (g GameState) GetWordLength() int {
+	return g.wordLength
+}
+
+func (g GameState) GetMaxGuesses() int {
+	return g.maxGuesses
+}
+
 func (g GameState) GetAnswer() string {
 	return g.answer
 }
@@ -235,6 +319,12 @@ func (g GameState) GetKnown() map[rune]CellState {
 	return g.knownLetters
 }
 
+// GetKnowledge is what the feedback so far says about the answer,
+// including how many times letters appear.
+func (g GameState) GetKnowledge() Knowledge {
+	return g.knowledge.Clone()
+}
+
 func (g GameState) GetStats() Stats {
 	return g.stats
 }
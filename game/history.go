@@ -0,0 +1,107 @@
+package game
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+// GameRecord is one finished game in the stats history.
+type GameRecord struct {
+	ID       string    `json:"id"`
+	Date     time.Time `json:"date"`
+	Answer   string    `json:"answer"`
+	Guesses  []string  `json:"guesses"`
+	Won      bool      `json:"won"`
+	Assisted bool      `json:"assisted,omitempty"`
+	// the daily puzzle number for daily games
+	Daily int `json:"daily,omitempty"`
+	// how long the game took
+	Seconds int `json:"seconds,omitempty"`
+	// milliseconds from the start of the game to each guess, for replays
+	Times []int `json:"times,omitempty"`
+	// the feedback rules for games not played with the standard ones
+	Rules string `json:"rules,omitempty"`
+	// how many guesses the game allowed
+	MaxGuesses int `json:"max_guesses,omitempty"`
+}
+
+// GuessLimit is how many guesses the game allowed, or fallback for games
+// recorded before that was kept.
+func (r GameRecord) GuessLimit(fallback int) int {
+	if r.MaxGuesses > 0 {
+		return r.MaxGuesses
+	}
+	return fallback
+}
+
+// Check reports records that can't be played back, e.g. from a hand edited
+// export: every guess must be as long as the answer.
+func (r GameRecord) Check() error {
+	if r.Answer == "" {
+		return errors.New("answer must not be empty")
+	}
+	length := utf8.RuneCountInString(r.Answer)
+	for i, guess := range r.Guesses {
+		if utf8.RuneCountInString(guess) != length {
+			return fmt.Errorf("guess %d %q must have %d letters like the answer", i+1, guess, length)
+		}
+	}
+
+	return nil
+}
+
+// newGameID returns a random identifier so records can be told apart when
+// stats from different machines are combined.
+func newGameID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (g GameState) record(won bool) GameRecord {
+	guesses := make([]string, g.currentRow)
+	for i := range g.currentRow {
+		word := make([]rune, len(g.guessesResults[i]))
+		for j, c := range g.guessesResults[i] {
+			word[j] = c.char
+		}
+		guesses[i] = string(word)
+	}
+	rules := ""
+	if g.evaluator != Standard {
+		rules = g.evaluator.Name()
+	}
+	times := make([]int, len(g.guessTimes))
+	for i, t := range g.guessTimes {
+		times[i] = int(t.Milliseconds())
+	}
+
+	return GameRecord{
+		ID:         newGameID(),
+		Date:       time.Now(),
+		Answer:     g.answer,
+		Guesses:    guesses,
+		Won:        won,
+		Assisted:   g.assisted,
+		Daily:      g.daily,
+		Seconds:    int(time.Since(g.started).Seconds()),
+		Times:      times,
+		Rules:      rules,
+		MaxGuesses: g.maxGuesses,
+	}
+}
+
+// MarkAssisted flags the game as played with help from the bot.
+func (g *GameState) MarkAssisted() {
+	if !g.finished {
+		g.assisted = true
+	}
+}
+
+func (g GameState) IsAssisted() bool {
+	return g.assisted
+}
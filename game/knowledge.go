@@ -0,0 +1,165 @@
+package game
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"koutaroyumiba/wordle/wordindex"
+)
+
+// Knowledge is what the feedback so far says about the answer, counting
+// repeated letters: a yellow E and a grey E in one guess mean exactly one
+// E, two yellow Es mean at least two.
+type Knowledge struct {
+	c wordindex.Constraints
+}
+
+func NewKnowledge(wordLength int) Knowledge {
+	return Knowledge{c: wordindex.NewConstraints(wordLength)}
+}
+
+// KnowledgeFrom works out the knowledge from the first played rows of a
+// board.
+func KnowledgeFrom(rows [][]Cell, played int) Knowledge {
+	k := NewKnowledge(0)
+	if len(rows) > 0 {
+		k = NewKnowledge(len(rows[0]))
+	}
+
+	for _, row := range rows[:min(played, len(rows))] {
+		k.AddRow(row)
+	}
+
+	return k
+}
+
+// Clone copies the knowledge so adding to it leaves the original alone.
+func (k Knowledge) Clone() Knowledge {
+	return Knowledge{c: k.c.Clone()}
+}
+
+// Constraints are the knowledge as the rules a candidate word has to
+// follow, ready to filter a word index with.
+func (k Knowledge) Constraints() wordindex.Constraints {
+	return k.c.Clone()
+}
+
+// Add takes in the feedback for one guess.
+func (k *Knowledge) Add(guess []rune, states []CellState) {
+	found := map[rune]int{}
+	grey := map[rune]bool{}
+	for i, char := range guess {
+		switch states[i] {
+		case StateCorrect:
+			k.c.Fixed[i] = char
+			found[char]++
+		case StatePresent:
+			k.c.Excluded[i][char] = true
+			found[char]++
+		case StateAbsent:
+			k.c.Excluded[i][char] = true
+			grey[char] = true
+		}
+	}
+
+	for char, n := range found {
+		k.c.Min[char] = max(k.c.Min[char], n)
+	}
+	// a grey copy means every copy the answer has was already coloured
+	for char := range grey {
+		if most, ok := k.c.Max[char]; ok {
+			k.c.Max[char] = min(most, found[char])
+		} else {
+			k.c.Max[char] = found[char]
+		}
+	}
+}
+
+// AddRow takes in a guessed row of the board.
+func (k *Knowledge) AddRow(row []Cell) {
+	guess := make([]rune, len(row))
+	states := make([]CellState, len(row))
+	for i, c := range row {
+		guess[i], states[i] = c.GetInfo()
+	}
+	k.Add(guess, states)
+}
+
+// Fixed is the letter known to be at position i.
+func (k Knowledge) Fixed(i int) (rune, bool) {
+	return k.c.Fixed[i], k.c.Fixed[i] != 0
+}
+
+// Excluded reports whether char is known not to be at position i.
+func (k Knowledge) Excluded(i int, char rune) bool {
+	return k.c.Excluded[i][char]
+}
+
+// MinCount is how many copies of char the answer has at least.
+func (k Knowledge) MinCount(char rune) int {
+	return k.c.Min[char]
+}
+
+// MaxCount is how many copies of char the answer has at most, if a grey
+// tile has given it away.
+func (k Knowledge) MaxCount(char rune) (int, bool) {
+	n, ok := k.c.Max[char]
+	return n, ok
+}
+
+// CountHint describes what is known about how often char appears, e.g.
+// "≥2" or "=1", or "" when there is nothing beyond the keyboard colour.
+func (k Knowledge) CountHint(char rune) string {
+	least := k.c.Min[char]
+	most, exact := k.c.Max[char]
+	switch {
+	case exact && least > 0 && most == least:
+		return fmt.Sprintf("=%d", least)
+	case least >= 2:
+		return fmt.Sprintf("≥%d", least)
+	}
+
+	return ""
+}
+
+// checkHardMode reports the first way guess ignores what is known: every
+// green must stay in place and every letter found must be used as often as
+// it is known to appear.
+func (k Knowledge) checkHardMode(guess []rune) (bool, string) {
+	for i, char := range k.c.Fixed {
+		if char != 0 && guess[i] != char {
+			return false, fmt.Sprintf("%s letter must be %c", ordinal(i+1), char)
+		}
+	}
+
+	counts := map[rune]int{}
+	for _, char := range guess {
+		counts[char]++
+	}
+	for _, char := range slices.Sorted(maps.Keys(k.c.Min)) {
+		if n := k.c.Min[char]; counts[char] < n {
+			if n == 1 {
+				return false, fmt.Sprintf("guess must contain %c", char)
+			}
+			return false, fmt.Sprintf("guess must contain %c %d times", char, n)
+		}
+	}
+
+	return true, ""
+}
+
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+
+	return fmt.Sprintf("%d%s", n, suffix)
+}
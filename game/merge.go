@@ -0,0 +1,116 @@
+package game
+
+import (
+	"maps"
+	"slices"
+)
+
+// MergeResult describes what merging another set of stats changed.
+type MergeResult struct {
+	Before Stats
+	After  Stats
+	// games from the other stats that were not in the history yet
+	Added []GameRecord
+	// games found in both histories, counted once
+	Duplicates int
+}
+
+// Merge combines the stats with other without counting a game twice. games
+// in the history are matched by ID and the totals are worked out again from
+// the combined history. games counted without a history entry (from before
+// the history was kept, or from the official web game) can't be matched, so
+// the larger of the two counts is kept.
+func (s Stats) Merge(other Stats) MergeResult {
+	seen := make(map[string]bool, len(s.History))
+	history := slices.Clone(s.History)
+	for _, r := range s.History {
+		seen[r.ID] = true
+	}
+
+	result := MergeResult{Before: s}
+	for _, r := range other.History {
+		if seen[r.ID] {
+			result.Duplicates++
+			continue
+		}
+		seen[r.ID] = true
+		history = append(history, r)
+		result.Added = append(result.Added, r)
+	}
+	slices.SortStableFunc(history, func(a, b GameRecord) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	untracked := s.untracked().max(other.untracked())
+	merged := statsFromHistory(history)
+
+	merged.GamesPlayed += untracked.GamesPlayed
+	merged.Wins += untracked.Wins
+	for guesses, count := range untracked.GuessFrequency {
+		merged.GuessFrequency[guesses] += count
+	}
+	// the untracked games came before the history, so their streak carries on
+	// if every game in the history was won
+	if merged.CurrentStreak == len(history) {
+		merged.CurrentStreak += untracked.CurrentStreak
+	}
+	merged.MaxStreak = max(merged.MaxStreak, merged.CurrentStreak, untracked.MaxStreak)
+
+	result.After = merged
+	return result
+}
+
+// statsFromHistory works out the totals of a history sorted oldest first.
+func statsFromHistory(history []GameRecord) Stats {
+	s := Stats{GuessFrequency: make(map[int]int), History: history}
+	for _, r := range history {
+		s.GamesPlayed++
+		if !r.Won {
+			s.CurrentStreak = 0
+			continue
+		}
+		s.Wins++
+		s.GuessFrequency[len(r.Guesses)]++
+		s.CurrentStreak++
+		s.MaxStreak = max(s.MaxStreak, s.CurrentStreak)
+	}
+
+	return s
+}
+
+// untracked is the part of the totals not covered by the history.
+func (s Stats) untracked() Stats {
+	tracked := statsFromHistory(s.History)
+	u := Stats{
+		GamesPlayed:    max(s.GamesPlayed-tracked.GamesPlayed, 0),
+		Wins:           max(s.Wins-tracked.Wins, 0),
+		MaxStreak:      s.MaxStreak,
+		GuessFrequency: make(map[int]int),
+	}
+	for guesses, count := range s.GuessFrequency {
+		if n := count - tracked.GuessFrequency[guesses]; n > 0 {
+			u.GuessFrequency[guesses] = n
+		}
+	}
+	// whatever of the current streak the history doesn't explain
+	if tracked.CurrentStreak == len(s.History) {
+		u.CurrentStreak = max(s.CurrentStreak-tracked.CurrentStreak, 0)
+	}
+
+	return u
+}
+
+func (s Stats) max(other Stats) Stats {
+	m := Stats{
+		GamesPlayed:    max(s.GamesPlayed, other.GamesPlayed),
+		Wins:           max(s.Wins, other.Wins),
+		CurrentStreak:  max(s.CurrentStreak, other.CurrentStreak),
+		MaxStreak:      max(s.MaxStreak, other.MaxStreak),
+		GuessFrequency: maps.Clone(s.GuessFrequency),
+	}
+	for guesses, count := range other.GuessFrequency {
+		m.GuessFrequency[guesses] = max(m.GuessFrequency[guesses], count)
+	}
+
+	return m
+}
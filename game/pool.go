@@ -0,0 +1,214 @@
+package game
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	PickRandom = "random"
+	PickEasy   = "easy"
+	PickHard   = "hard"
+)
+
+// PoolOptions decide which answer a new game gets.
+type PoolOptions struct {
+	// answers never to pick, e.g. past answers of the official game
+	Exclude []string
+	// PickRandom, or PickEasy / PickHard to lean towards answers Difficulty
+	// scores low or high
+	Pick string
+	// how hard an answer is, higher is harder, on any scale
+	Difficulty func(answer string) float64
+	// only answers rated in this tier, one of Tiers or TierAny
+	Tier string
+}
+
+// PickAnswer draws an answer the player hasn't had yet according to the
+// history. once every answer has come up the pool starts over, so answers
+// played fewer times than the rest always come first. excluded answers are
+// never drawn, if they use up the tier the answer comes from any tier.
+func PickAnswer(answers []string, history []GameRecord, opts PoolOptions) string {
+	pool := UnplayedAnswers(answersInTier(answers, opts.Tier), history, opts.Exclude)
+	if len(pool) == 0 {
+		pool = UnplayedAnswers(answers, history, opts.Exclude)
+	}
+	// only when everything is excluded, which config.Validate rules out
+	if len(pool) == 0 {
+		return pickRandomWord(answers)
+	}
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	if opts.Pick == PickRandom || opts.Pick == "" || opts.Difficulty == nil {
+		return pool[rng.Intn(len(pool))]
+	}
+
+	// scale difficulty to 0..1 over the pool and weigh answers up to five
+	// times as likely as the least favoured ones
+	scores := make([]float64, len(pool))
+	lo, hi := 0.0, 0.0
+	for i, answer := range pool {
+		scores[i] = opts.Difficulty(answer)
+		if i == 0 || scores[i] < lo {
+			lo = scores[i]
+		}
+		if i == 0 || scores[i] > hi {
+			hi = scores[i]
+		}
+	}
+
+	weights := make([]float64, len(pool))
+	total := 0.0
+	for i, score := range scores {
+		d := 0.5
+		if hi > lo {
+			d = (score - lo) / (hi - lo)
+		}
+		if opts.Pick == PickEasy {
+			d = 1 - d
+		}
+		weights[i] = 1 + 4*d
+		total += weights[i]
+	}
+
+	r := rng.Float64() * total
+	for i, w := range weights {
+		r -= w
+		if r < 0 {
+			return pool[i]
+		}
+	}
+
+	return pool[len(pool)-1]
+}
+
+// UnplayedAnswers are the answers, less the excluded ones, that came up the
+// fewest times in the history.
+func UnplayedAnswers(answers []string, history []GameRecord, exclude []string) []string {
+	excluded := make(map[string]bool, len(exclude))
+	for _, word := range exclude {
+		excluded[word] = true
+	}
+	played := map[string]int{}
+	for _, r := range history {
+		played[r.Answer]++
+	}
+
+	fewest := -1
+	for _, answer := range answers {
+		if excluded[answer] {
+			continue
+		}
+		if fewest < 0 || played[answer] < fewest {
+			fewest = played[answer]
+		}
+	}
+
+	pool := []string{}
+	for _, answer := range answers {
+		if !excluded[answer] && played[answer] == fewest {
+			pool = append(pool, answer)
+		}
+	}
+
+	return pool
+}
+
+// FrequencyDifficulty rates answers by how common they are in words, a list
+// ordered most common first. words missing from the list are the hardest.
+func FrequencyDifficulty(words []string) func(string) float64 {
+	rank := make(map[string]int, len(words))
+	for i, word := range words {
+		rank[word] = i
+	}
+
+	return func(answer string) float64 {
+		if r, ok := rank[answer]; ok {
+			return float64(r)
+		}
+		return float64(len(words))
+	}
+}
+
+// HistoryDifficulty rates answers by how the player did on past answers
+// that look alike (all but one letter in the same place, like the _IGHT
+// words): the average number of guesses, a loss counting as
+// maxGuesses+1. answers with no look-alikes get the overall average.
+func HistoryDifficulty(history []GameRecord, maxGuesses int) func(string) float64 {
+	guesses := func(r GameRecord) float64 {
+		if !r.Won {
+			return float64(maxGuesses + 1)
+		}
+		return float64(len(r.Guesses))
+	}
+
+	overall := 0.0
+	for _, r := range history {
+		overall += guesses(r)
+	}
+	if len(history) > 0 {
+		overall /= float64(len(history))
+	}
+
+	return func(answer string) float64 {
+		total, n := 0.0, 0
+		for _, r := range history {
+			if lookAlike(answer, r.Answer) {
+				total += guesses(r)
+				n++
+			}
+		}
+		if n == 0 {
+			return overall
+		}
+		return total / float64(n)
+	}
+}
+
+func lookAlike(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) != len(rb) {
+		return false
+	}
+
+	same := 0
+	for i := range ra {
+		if ra[i] == rb[i] {
+			same++
+		}
+	}
+
+	return same >= len(ra)-1
+}
+
+// ReadWordList reads one word per line, skipping blank lines and lines
+// starting with #, e.g. a list of past official answers.
+func ReadWordList(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(f)
+	line := 0
+	for scanner.Scan() {
+		line++
+		word := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+		if strings.ContainsAny(word, " \t,") || !utf8.ValidString(word) {
+			return nil, fmt.Errorf("%s: line %d: expected a single word (got %q)", path, line, word)
+		}
+		words = append(words, word)
+	}
+
+	return words, scanner.Err()
+}
@@ -0,0 +1,178 @@
+package game
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// DayActivity is how many games were played and won on one day.
+type DayActivity struct {
+	Date   time.Time
+	Played int
+	Wins   int
+}
+
+// WeekSummary collects the games of the week starting on Start (a monday).
+type WeekSummary struct {
+	Start        time.Time
+	Played       int
+	Wins         int
+	WonGuesses   int
+	WinRate      float64
+	AverageGuess float64
+}
+
+// WordCount is a word and how often it came up.
+type WordCount struct {
+	Word  string
+	Count int
+}
+
+// LastGame is the most recent game in the history.
+func (s Stats) LastGame() (GameRecord, bool) {
+	if len(s.History) == 0 {
+		return GameRecord{}, false
+	}
+
+	return s.History[len(s.History)-1], true
+}
+
+// RecentGames are the last n games, newest first.
+func (s Stats) RecentGames(n int) []GameRecord {
+	recent := []GameRecord{}
+	for i := len(s.History) - 1; i >= 0 && len(recent) < n; i-- {
+		recent = append(recent, s.History[i])
+	}
+
+	return recent
+}
+
+// Calendar returns the activity of each of the last days days up to and
+// including today, oldest first.
+func (s Stats) Calendar(today time.Time, days int) []DayActivity {
+	first := startOfDay(today).AddDate(0, 0, -(days - 1))
+	calendar := make([]DayActivity, days)
+	for i := range calendar {
+		calendar[i].Date = first.AddDate(0, 0, i)
+	}
+
+	for _, r := range s.History {
+		i := daysBetween(first, startOfDay(r.Date.In(today.Location())))
+		if i < 0 || i >= days {
+			continue
+		}
+		calendar[i].Played++
+		if r.Won {
+			calendar[i].Wins++
+		}
+	}
+
+	return calendar
+}
+
+// Weekly summarises the last weeks weeks up to the current one, oldest
+// first, for plotting win rate and average guesses over time.
+func (s Stats) Weekly(today time.Time, weeks int) []WeekSummary {
+	thisWeek := startOfWeek(today)
+	first := thisWeek.AddDate(0, 0, -7*(weeks-1))
+	summary := make([]WeekSummary, weeks)
+	for i := range summary {
+		summary[i].Start = first.AddDate(0, 0, 7*i)
+	}
+
+	for _, r := range s.History {
+		i := daysBetween(first, startOfDay(r.Date.In(today.Location()))) / 7
+		if r.Date.Before(first) || i >= weeks {
+			continue
+		}
+		summary[i].Played++
+		if r.Won {
+			summary[i].Wins++
+			summary[i].WonGuesses += len(r.Guesses)
+		}
+	}
+
+	for i := range summary {
+		w := &summary[i]
+		if w.Played > 0 {
+			w.WinRate = float64(w.Wins) / float64(w.Played) * 100
+		}
+		if w.Wins > 0 {
+			w.AverageGuess = float64(w.WonGuesses) / float64(w.Wins)
+		}
+	}
+
+	return summary
+}
+
+// HardestAnswers are the answers that took the most guesses, losses first.
+// an answer played more than once is listed by its worst game.
+func (s Stats) HardestAnswers(n int) []GameRecord {
+	records := slices.Clone(s.History)
+	slices.SortStableFunc(records, func(a, b GameRecord) int {
+		if a.Won != b.Won {
+			if !a.Won {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(len(b.Guesses), len(a.Guesses))
+	})
+
+	seen := map[string]bool{}
+	hardest := make([]GameRecord, 0, n)
+	for _, r := range records {
+		if len(hardest) == n {
+			break
+		}
+		if seen[r.Answer] {
+			continue
+		}
+		seen[r.Answer] = true
+		hardest = append(hardest, r)
+	}
+
+	return hardest
+}
+
+// StartingWords are the most used first guesses.
+func (s Stats) StartingWords(n int) []WordCount {
+	counts := map[string]int{}
+	for _, r := range s.History {
+		if len(r.Guesses) > 0 {
+			counts[r.Guesses[0]]++
+		}
+	}
+
+	words := make([]WordCount, 0, len(counts))
+	for word, count := range counts {
+		words = append(words, WordCount{Word: word, Count: count})
+	}
+	slices.SortFunc(words, func(a, b WordCount) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Word, b.Word)
+	})
+
+	return words[:min(n, len(words))]
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func startOfWeek(t time.Time) time.Time {
+	day := startOfDay(t)
+	offset := (int(day.Weekday()) + 6) % 7 // days since monday
+	return day.AddDate(0, 0, -offset)
+}
+
+// daysBetween counts calendar days, which is not always 24 hours apart
+// across daylight saving changes.
+func daysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
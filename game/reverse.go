@@ -0,0 +1,99 @@
+package game
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"time"
+
+	"koutaroyumiba/wordle/wordindex"
+)
+
+// ReversePuzzle is wordle played backwards: the answer and the colours of a
+// few guesses are shown, and the player finds a word for each row that
+// gives those colours.
+type ReversePuzzle struct {
+	Answer string
+	// the feedback of each row, from fewest letters found to most
+	Patterns   [][]CellState
+	dictionary *wordindex.Index
+}
+
+// NewReversePuzzle makes a puzzle of up to rows patterns for answer. each
+// is the feedback of a word from words, so every row can be solved, and
+// they are spread from little found to nearly solved like a real game.
+func NewReversePuzzle(answer string, words []string, rows int) ReversePuzzle {
+	length := len([]rune(answer))
+	puzzle := ReversePuzzle{Answer: answer, dictionary: wordindex.Shared(words, length)}
+
+	seen := map[string]bool{}
+	patterns := [][]CellState{}
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for _, i := range rng.Perm(len(words)) {
+		guess := []rune(words[i])
+		if len(guess) != length || words[i] == answer {
+			continue
+		}
+		pattern := EvaluateGuess([]rune(answer), guess)
+		// an all grey row is too easy to be worth a row
+		if progress(pattern) == 0 {
+			continue
+		}
+		if key := patternKey(pattern); !seen[key] {
+			seen[key] = true
+			patterns = append(patterns, pattern)
+		}
+	}
+	if len(patterns) == 0 || rows < 1 {
+		return puzzle
+	}
+
+	slices.SortStableFunc(patterns, func(a, b []CellState) int {
+		return cmp.Compare(progress(a), progress(b))
+	})
+	rows = min(rows, len(patterns))
+	for i := range rows {
+		at := 0
+		if rows > 1 {
+			at = i * (len(patterns) - 1) / (rows - 1)
+		}
+		puzzle.Patterns = append(puzzle.Patterns, patterns[at])
+	}
+
+	return puzzle
+}
+
+// Check says whether guess solves row: it has to be in the word list and
+// give the row's colours against the answer.
+func (p ReversePuzzle) Check(row int, guess string) (bool, string) {
+	if !p.dictionary.Contains(guess) {
+		return false, "not in word list"
+	}
+	if !slices.Equal(EvaluateGuess([]rune(p.Answer), []rune(guess)), p.Patterns[row]) {
+		return false, "that word gives other colours"
+	}
+
+	return true, ""
+}
+
+// progress weighs a pattern by how much of the answer it has found.
+func progress(pattern []CellState) int {
+	score := 0
+	for _, s := range pattern {
+		switch s {
+		case StateCorrect:
+			score += 2
+		case StatePresent:
+			score++
+		}
+	}
+	return score
+}
+
+func patternKey(pattern []CellState) string {
+	key := make([]byte, len(pattern))
+	for i, s := range pattern {
+		key[i] = byte('0' + s)
+	}
+	return string(key)
+}
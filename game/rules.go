@@ -0,0 +1,159 @@
+package game
+
+import (
+	"crypto/sha256"
+	"slices"
+)
+
+const (
+	RulesStandard = "standard"
+	RulesFibble   = "fibble"
+	RulesCount    = "count"
+	RulesNoYellow = "no-yellow"
+)
+
+// Evaluator decides the feedback a guess gets, the rules of a game.
+type Evaluator interface {
+	// Name is one of RuleNames.
+	Name() string
+	// Evaluate is the feedback shown for guess. it must be the same every
+	// time for the same answer and guess so finished games can be replayed.
+	Evaluate(answer, guess []rune) []CellState
+	// Consistent tells whether answer could have given feedback to guess,
+	// for the assistant to narrow down the words.
+	Consistent(answer, guess []rune, feedback []CellState) bool
+	// Positional is false when only the number of each state counts, not
+	// which letter it is shown under.
+	Positional() bool
+}
+
+// RuleNames are the feedback variants games can be played with.
+var RuleNames = []string{RulesStandard, RulesFibble, RulesCount, RulesNoYellow}
+
+// RuleDescriptions say in a few words what the feedback of each variant
+// tells.
+var RuleDescriptions = map[string]string{
+	RulesStandard: "letters in the right place are green, in the wrong place yellow, not in the word grey",
+	RulesFibble:   "one letter of every guess shows the wrong colour",
+	RulesCount:    "only how many letters are in the right place and how many in the wrong place, not which",
+	RulesNoYellow: "only letters in the right place are marked, everything else is grey",
+}
+
+// Standard is the usual green, yellow and grey feedback.
+var Standard Evaluator = standard{}
+
+var rules = map[string]Evaluator{
+	RulesStandard: Standard,
+	RulesFibble:   fibble{},
+	RulesCount:    count{},
+	RulesNoYellow: noYellow{},
+}
+
+// RulesFor is the evaluator of a variant, the standard one for "" so older
+// stats records replay as they were played.
+func RulesFor(name string) (Evaluator, bool) {
+	if name == "" {
+		return Standard, true
+	}
+	ev, ok := rules[name]
+	return ev, ok
+}
+
+type standard struct{}
+
+func (standard) Name() string     { return RulesStandard }
+func (standard) Positional() bool { return true }
+
+func (standard) Evaluate(answer, guess []rune) []CellState {
+	return EvaluateGuess(answer, guess)
+}
+
+func (standard) Consistent(answer, guess []rune, feedback []CellState) bool {
+	return slices.Equal(EvaluateGuess(answer, guess), feedback)
+}
+
+// fibble tells one lie per row: one tile shows one of the two states it
+// doesn't have. which tile and what lie follow from the answer and the
+// guess, so guessing a word again doesn't expose the lie.
+type fibble struct{}
+
+func (fibble) Name() string     { return RulesFibble }
+func (fibble) Positional() bool { return true }
+
+func (fibble) Evaluate(answer, guess []rune) []CellState {
+	states := EvaluateGuess(answer, guess)
+	sum := sha256.Sum256([]byte(string(answer) + ":" + string(guess)))
+	lie := int(sum[0]) % len(states)
+
+	others := []CellState{}
+	for _, s := range []CellState{StateCorrect, StatePresent, StateAbsent} {
+		if s != states[lie] {
+			others = append(others, s)
+		}
+	}
+	states[lie] = others[sum[1]%2]
+
+	return states
+}
+
+func (fibble) Consistent(answer, guess []rune, feedback []CellState) bool {
+	lies := 0
+	for i, s := range EvaluateGuess(answer, guess) {
+		if s != feedback[i] {
+			lies++
+		}
+	}
+	return lies == 1
+}
+
+// count is Mastermind style: how many letters are correct and how many are
+// present, not which ones. the states are sorted correct first.
+type count struct{}
+
+func (count) Name() string     { return RulesCount }
+func (count) Positional() bool { return false }
+
+func (count) Evaluate(answer, guess []rune) []CellState {
+	states := EvaluateGuess(answer, guess)
+	slices.Sort(states)
+	return states
+}
+
+func (c count) Consistent(answer, guess []rune, feedback []CellState) bool {
+	return slices.Equal(c.Evaluate(answer, guess), feedback)
+}
+
+// noYellow only marks the correct letters, letters in the wrong place are
+// grey like the absent ones.
+type noYellow struct{}
+
+func (noYellow) Name() string     { return RulesNoYellow }
+func (noYellow) Positional() bool { return true }
+
+func (noYellow) Evaluate(answer, guess []rune) []CellState {
+	states := EvaluateGuess(answer, guess)
+	for i, s := range states {
+		if s == StatePresent {
+			states[i] = StateAbsent
+		}
+	}
+	return states
+}
+
+func (n noYellow) Consistent(answer, guess []rune, feedback []CellState) bool {
+	return slices.Equal(n.Evaluate(answer, guess), feedback)
+}
+
+// Feedback counts the states of a row, e.g. for count rules where their
+// order doesn't say anything.
+func Feedback(row []Cell) (correct, present int) {
+	for _, c := range row {
+		switch c.state {
+		case StateCorrect:
+			correct++
+		case StatePresent:
+			present++
+		}
+	}
+	return correct, present
+}
@@ -5,14 +5,20 @@ import (
 	"os"
 )
 
-const statsFile = "stats.json"
+var statsFile = "stats.json"
+
+// SetStatsPath changes where stats are loaded from and saved to.
+func SetStatsPath(path string) {
+	statsFile = path
+}
 
 type Stats struct {
-	GamesPlayed    int         `json:"games_played"`
-	Wins           int         `json:"wins"`
-	CurrentStreak  int         `json:"current_streak"`
-	MaxStreak      int         `json:"max_streak"`
-	GuessFrequency map[int]int `json:"guess_frequency"`
+	GamesPlayed    int          `json:"games_played"`
+	Wins           int          `json:"wins"`
+	CurrentStreak  int          `json:"current_streak"`
+	MaxStreak      int          `json:"max_streak"`
+	GuessFrequency map[int]int  `json:"guess_frequency"`
+	History        []GameRecord `json:"history,omitempty"`
 }
 
 func (s Stats) WinRate() float64 {
@@ -36,6 +42,18 @@ func (s Stats) AverageGuesses() float64 {
 	return float64(total) / float64(s.Wins)
 }
 
+// AssistedGames counts the games in the history played with the bot's help.
+func (s Stats) AssistedGames() int {
+	count := 0
+	for _, r := range s.History {
+		if r.Assisted {
+			count++
+		}
+	}
+
+	return count
+}
+
 func loadStats() Stats {
 	f, err := os.ReadFile(statsFile)
 	if err != nil {
@@ -0,0 +1,295 @@
+package game
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the columns after assisted were added later and may be missing from older
+// files
+var csvHeader = []string{"id", "date", "answer", "guesses", "won", "assisted", "daily", "seconds", "times", "rules", "max_guesses"}
+
+// how many columns every file has
+const csvRequired = 6
+
+// nytStats is the statistics object the official web game keeps in the
+// browser's local storage.
+type nytStats struct {
+	CurrentStreak *int           `json:"currentStreak"`
+	MaxStreak     int            `json:"maxStreak"`
+	Guesses       map[string]int `json:"guesses"`
+	GamesPlayed   *int           `json:"gamesPlayed"`
+	GamesWon      int            `json:"gamesWon"`
+}
+
+// ReadStatsFile loads a stats file, reporting problems instead of starting
+// over like the game does. a missing file is empty stats.
+func ReadStatsFile(path string) (Stats, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Stats{GuessFrequency: make(map[int]int)}, nil
+	}
+	if err != nil {
+		return Stats{}, err
+	}
+	defer f.Close()
+
+	s, err := ReadJSON(f)
+	if err != nil {
+		return Stats{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// WriteFile saves the stats to path in the format the game reads.
+func (s Stats) WriteFile(path string) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// WriteJSON writes the stats and history as indented JSON.
+func (s Stats) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// ReadJSON reads stats written by WriteJSON or the stats file, or the
+// statistics exported from the official web game. the latter only has
+// totals, so the games it counts have no history.
+func ReadJSON(r io.Reader) (Stats, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	var nyt nytStats
+	if err := json.Unmarshal(data, &nyt); err != nil {
+		return Stats{}, err
+	}
+	if nyt.GamesPlayed != nil {
+		return nyt.stats()
+	}
+
+	var s Stats
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&s); err != nil {
+		return Stats{}, err
+	}
+	if s.GuessFrequency == nil {
+		s.GuessFrequency = make(map[int]int)
+	}
+	// the same checks and ids as games imported from CSV
+	for i, r := range s.History {
+		if err := r.Check(); err != nil {
+			return Stats{}, fmt.Errorf("game %d: %w", i+1, err)
+		}
+		if r.ID == "" {
+			s.History[i].ID = contentID(r)
+		}
+	}
+
+	return s, nil
+}
+
+func (n nytStats) stats() (Stats, error) {
+	s := Stats{
+		GamesPlayed:    *n.GamesPlayed,
+		Wins:           n.GamesWon,
+		MaxStreak:      n.MaxStreak,
+		GuessFrequency: make(map[int]int),
+	}
+	if n.CurrentStreak != nil {
+		s.CurrentStreak = *n.CurrentStreak
+	}
+
+	for key, count := range n.Guesses {
+		if key == "fail" {
+			continue
+		}
+		guesses, err := strconv.Atoi(key)
+		if err != nil || guesses < 1 {
+			return Stats{}, fmt.Errorf("unknown guess count %q", key)
+		}
+		if count > 0 {
+			s.GuessFrequency[guesses] = count
+		}
+	}
+
+	return s, nil
+}
+
+// WriteCSV writes the history, one game per row. guesses are separated by
+// spaces.
+func (s Stats) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, r := range s.History {
+		row := []string{
+			r.ID,
+			r.Date.Format(time.RFC3339),
+			r.Answer,
+			strings.Join(r.Guesses, " "),
+			strconv.FormatBool(r.Won),
+			strconv.FormatBool(r.Assisted),
+			formatOptional(r.Daily),
+			formatOptional(r.Seconds),
+			joinInts(r.Times),
+			r.Rules,
+			formatOptional(r.MaxGuesses),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatOptional(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+func joinInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(v)
+	}
+	return strings.Join(parts, " ")
+}
+
+// ReadCSV reads a history written by WriteCSV and works out the totals from
+// it. rows without an id get one made from their contents, so importing the
+// same file twice does not count its games twice. files from older versions
+// without the later columns can be read too.
+func ReadCSV(r io.Reader) (Stats, error) {
+	cr := csv.NewReader(r)
+
+	header, err := cr.Read()
+	if err != nil {
+		return Stats{}, fmt.Errorf("reading header: %w", err)
+	}
+	if len(header) < csvRequired || len(header) > len(csvHeader) || strings.Join(header, ",") != strings.Join(csvHeader[:len(header)], ",") {
+		return Stats{}, fmt.Errorf("header must be %s", strings.Join(csvHeader, ","))
+	}
+
+	var history []GameRecord
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Stats{}, err
+		}
+
+		line, _ := cr.FieldPos(0)
+		record, err := parseCSVRecord(row)
+		if err != nil {
+			return Stats{}, fmt.Errorf("line %d: %w", line, err)
+		}
+		history = append(history, record)
+	}
+
+	return statsFromHistory(history), nil
+}
+
+func parseCSVRecord(row []string) (GameRecord, error) {
+	date, err := time.Parse(time.RFC3339, row[1])
+	if err != nil {
+		return GameRecord{}, fmt.Errorf("date must be RFC 3339 (got %q)", row[1])
+	}
+	won, err := strconv.ParseBool(row[4])
+	if err != nil {
+		return GameRecord{}, fmt.Errorf("won must be true or false (got %q)", row[4])
+	}
+	assisted := false
+	if row[5] != "" {
+		if assisted, err = strconv.ParseBool(row[5]); err != nil {
+			return GameRecord{}, fmt.Errorf("assisted must be true or false (got %q)", row[5])
+		}
+	}
+
+	r := GameRecord{
+		ID:       row[0],
+		Date:     date,
+		Answer:   row[2],
+		Guesses:  strings.Fields(row[3]),
+		Won:      won,
+		Assisted: assisted,
+	}
+	if err := r.Check(); err != nil {
+		return GameRecord{}, err
+	}
+
+	// the optional columns
+	optional := func(i int) string {
+		if i < len(row) {
+			return row[i]
+		}
+		return ""
+	}
+	if r.Daily, err = parseOptional(optional(6)); err != nil {
+		return GameRecord{}, fmt.Errorf("daily must be a number (got %q)", row[6])
+	}
+	if r.Seconds, err = parseOptional(optional(7)); err != nil {
+		return GameRecord{}, fmt.Errorf("seconds must be a number (got %q)", row[7])
+	}
+	for _, field := range strings.Fields(optional(8)) {
+		ms, err := strconv.Atoi(field)
+		if err != nil {
+			return GameRecord{}, fmt.Errorf("times must be numbers (got %q)", row[8])
+		}
+		r.Times = append(r.Times, ms)
+	}
+	r.Rules = optional(9)
+	if _, ok := RulesFor(r.Rules); !ok {
+		return GameRecord{}, fmt.Errorf("rules must be one of %s (got %q)", strings.Join(RuleNames, ", "), r.Rules)
+	}
+	if r.MaxGuesses, err = parseOptional(optional(10)); err != nil {
+		return GameRecord{}, fmt.Errorf("max_guesses must be a number (got %q)", row[10])
+	}
+
+	if r.ID == "" {
+		r.ID = contentID(r)
+	}
+
+	return r, nil
+}
+
+func parseOptional(field string) (int, error) {
+	if field == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(field)
+}
+
+// contentID is a stable identifier for a record that came without one.
+func contentID(r GameRecord) string {
+	sum := sha256.Sum256([]byte(r.Date.UTC().Format(time.RFC3339) + "|" + r.Answer + "|" + strings.Join(r.Guesses, " ")))
+	return hex.EncodeToString(sum[:8])
+}
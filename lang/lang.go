@@ -0,0 +1,132 @@
+package lang
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"koutaroyumiba/wordle/data"
+)
+
+// Language is everything needed to play in one language: the letters a
+// guess may use, how typed text is folded onto them and the word lists.
+type Language struct {
+	Code     string
+	Name     string
+	Alphabet []rune
+	// Layout is the keyboard layout that covers the whole alphabet.
+	Layout  string
+	Answers []string
+	Words   []string
+	// Fold replaces letters that are not part of the alphabet (accents the
+	// word lists ignore, ß and so on) before a guess is checked.
+	Fold map[rune]string
+}
+
+const Default = "en"
+
+var latin = []rune("abcdefghijklmnopqrstuvwxyz")
+
+var languages = map[string]Language{
+	"en": {
+		Code:     "en",
+		Name:     "English",
+		Alphabet: latin,
+		Layout:   "qwerty",
+		Answers:  data.ValidAnswers5,
+		Words:    data.ValidWords5,
+	},
+	"es": {
+		Code:     "es",
+		Name:     "Español",
+		Alphabet: withLetters('ñ'),
+		Layout:   "spanish",
+		Answers:  data.SpanishWords5,
+		Words:    data.SpanishWords5,
+		Fold: map[rune]string{
+			'á': "a", 'é': "e", 'í': "i", 'ó': "o", 'ú': "u", 'ü': "u",
+		},
+	},
+	"de": {
+		Code:     "de",
+		Name:     "Deutsch",
+		Alphabet: withLetters('ä', 'ö', 'ü'),
+		Layout:   "german",
+		Answers:  data.GermanWords5,
+		Words:    data.GermanWords5,
+		Fold: map[rune]string{
+			'ß': "ss",
+		},
+	},
+	"pt": {
+		Code:     "pt",
+		Name:     "Português",
+		Alphabet: latin,
+		Layout:   "qwerty",
+		Answers:  data.PortugueseWords5,
+		Words:    data.PortugueseWords5,
+		Fold: map[rune]string{
+			'á': "a", 'à': "a", 'â': "a", 'ã': "a",
+			'é': "e", 'ê': "e",
+			'í': "i",
+			'ó': "o", 'ô': "o", 'õ': "o",
+			'ú': "u", 'ü': "u",
+			'ç': "c",
+		},
+	},
+}
+
+func withLetters(extra ...rune) []rune {
+	return append(slices.Clone(latin), extra...)
+}
+
+// Get looks up a language by its code, e.g. "es".
+func Get(code string) (Language, bool) {
+	l, ok := languages[code]
+	return l, ok
+}
+
+// Codes lists the available language codes, english first.
+func Codes() []string {
+	codes := []string{Default}
+	for code := range languages {
+		if code != Default {
+			codes = append(codes, code)
+		}
+	}
+	slices.Sort(codes[1:])
+
+	return codes
+}
+
+// Normalize lowercases s and folds it onto the alphabet, so "Niño" and
+// "NIÑO" both become "niño" and a portuguese "Avião" becomes "aviao".
+func (l Language) Normalize(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		r = unicode.ToLower(r)
+		if folded, ok := l.Fold[r]; ok {
+			b.WriteString(folded)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+func (l Language) InAlphabet(r rune) bool {
+	return slices.Contains(l.Alphabet, r)
+}
+
+// ValidLetters reports whether every letter of an already normalised word
+// is in the alphabet.
+func (l Language) ValidLetters(word string) bool {
+	for _, r := range word {
+		if !l.InAlphabet(r) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,104 @@
+package leaderboard
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/game"
+)
+
+// Client talks to a leaderboard server.
+type Client struct {
+	url  string
+	key  string
+	http *http.Client
+}
+
+func NewClient(serverURL, key string) Client {
+	return Client{
+		url:  strings.TrimSuffix(serverURL, "/"),
+		key:  key,
+		http: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// Submit signs the result and sends it to the server.
+func (c Client) Submit(r Result) error {
+	body, err := json.Marshal(r.Sign(c.key))
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.http.Post(c.url+"/results", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusCreated:
+		return nil
+	case http.StatusConflict:
+		return ErrDuplicate
+	default:
+		return responseError(resp)
+	}
+}
+
+// Board fetches the leaderboard of a day in a language.
+func (c Client) Board(day int, language string) (Board, error) {
+	resp, err := c.http.Get(c.url + "/leaderboard?" + url.Values{"day": {strconv.Itoa(day)}, "lang": {language}}.Encode())
+	if err != nil {
+		return Board{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Board{}, responseError(resp)
+	}
+
+	var board Board
+	if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
+		return Board{}, fmt.Errorf("bad leaderboard: %w", err)
+	}
+
+	return board, nil
+}
+
+func responseError(resp *http.Response) error {
+	var body struct {
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil || body.Error == "" {
+		return fmt.Errorf("leaderboard server: %s", resp.Status)
+	}
+
+	return fmt.Errorf("leaderboard server: %s", body.Error)
+}
+
+// SubmitGame sends a finished daily game to the configured server under
+// the player's name. it reports false without error when there is nothing
+// to send: no server configured or not a daily game, and ErrAssisted for
+// games the assistant helped with.
+func SubmitGame(cfg config.Config, g game.GameState) (bool, error) {
+	if cfg.Leaderboard == "" || g.GetDaily() == 0 {
+		return false, nil
+	}
+	last, ok := g.GetStats().LastGame()
+	if !ok || last.Daily != g.GetDaily() {
+		return false, nil
+	}
+	if last.Assisted {
+		return false, ErrAssisted
+	}
+
+	err := NewClient(cfg.Leaderboard, cfg.LeaderboardKey).Submit(FromRecord(cfg.PlayerName(), cfg.Language, last))
+	return err == nil, err
+}
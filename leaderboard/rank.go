@@ -0,0 +1,189 @@
+package leaderboard
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Board is what the server answers a leaderboard request with.
+type Board struct {
+	Day      int    `json:"day"`
+	Language string `json:"language"`
+	// the day's results, best first
+	Daily   []DailyEntry  `json:"daily"`
+	Players []PlayerEntry `json:"players"`
+}
+
+type DailyEntry struct {
+	Rank    int    `json:"rank"`
+	Player  string `json:"player"`
+	Guesses int    `json:"guesses"`
+	Won     bool   `json:"won"`
+	Seconds int    `json:"seconds"`
+}
+
+// PlayerEntry sums up a player's results up to the board's day.
+type PlayerEntry struct {
+	Player         string  `json:"player"`
+	Played         int     `json:"played"`
+	Wins           int     `json:"wins"`
+	AverageGuesses float64 `json:"average_guesses"`
+	AverageSeconds float64 `json:"average_seconds"`
+	BestSeconds    int     `json:"best_seconds"`
+	CurrentStreak  int     `json:"current_streak"`
+	MaxStreak      int     `json:"max_streak"`
+}
+
+// Rank builds the board for a day and language from every result. later
+// days are left out so old boards don't change.
+func Rank(results []Result, day int, language string) Board {
+	board := Board{Day: day, Language: language, Daily: []DailyEntry{}, Players: []PlayerEntry{}}
+
+	byPlayer := map[string][]Result{}
+	for _, r := range results {
+		if r.Day > day || r.lang() != language {
+			continue
+		}
+		byPlayer[r.Player] = append(byPlayer[r.Player], r)
+		if r.Day == day {
+			board.Daily = append(board.Daily, DailyEntry{Player: r.Player, Guesses: r.Guesses, Won: r.Won, Seconds: r.Seconds})
+		}
+	}
+
+	slices.SortFunc(board.Daily, compareDaily)
+	for i := range board.Daily {
+		board.Daily[i].Rank = i + 1
+	}
+
+	for player, played := range byPlayer {
+		board.Players = append(board.Players, summarise(player, played, day))
+	}
+	SortByGuesses(board.Players)
+
+	return board
+}
+
+// compareDaily puts wins first, then fewer guesses, then faster games.
+func compareDaily(a, b DailyEntry) int {
+	return cmp.Or(
+		compareMissing(!a.Won, !b.Won),
+		cmp.Compare(a.Guesses, b.Guesses),
+		cmp.Compare(a.Seconds, b.Seconds),
+		cmp.Compare(a.Player, b.Player),
+	)
+}
+
+func summarise(player string, results []Result, day int) PlayerEntry {
+	e := PlayerEntry{Player: player, Played: len(results)}
+
+	won := map[int]bool{}
+	guesses, seconds := 0, 0
+	for _, r := range results {
+		if !r.Won {
+			continue
+		}
+		e.Wins++
+		won[r.Day] = true
+		guesses += r.Guesses
+		seconds += r.Seconds
+		if e.BestSeconds == 0 || r.Seconds < e.BestSeconds {
+			e.BestSeconds = r.Seconds
+		}
+	}
+	if e.Wins > 0 {
+		e.AverageGuesses = float64(guesses) / float64(e.Wins)
+		e.AverageSeconds = float64(seconds) / float64(e.Wins)
+	}
+
+	// a streak is consecutive days won. it is still current when the day
+	// of the board hasn't been played yet
+	days := []int{}
+	for d := range won {
+		days = append(days, d)
+	}
+	slices.Sort(days)
+	run := 0
+	for i, d := range days {
+		if i > 0 && d == days[i-1]+1 {
+			run++
+		} else {
+			run = 1
+		}
+		e.MaxStreak = max(e.MaxStreak, run)
+	}
+	d := day
+	if !played(results, day) {
+		d--
+	}
+	for ; won[d]; d-- {
+		e.CurrentStreak++
+	}
+
+	return e
+}
+
+func played(results []Result, day int) bool {
+	for _, r := range results {
+		if r.Day == day {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Rankings are the ways to order players by name.
+var Rankings = map[string]func([]PlayerEntry){
+	"guesses": SortByGuesses,
+	"time":    SortByTime,
+	"streak":  SortByStreak,
+}
+
+// SortByGuesses ranks players by fewest guesses on average, players
+// without a win last.
+func SortByGuesses(players []PlayerEntry) {
+	slices.SortFunc(players, func(a, b PlayerEntry) int {
+		return cmp.Or(
+			compareMissing(a.Wins == 0, b.Wins == 0),
+			cmp.Compare(a.AverageGuesses, b.AverageGuesses),
+			cmp.Compare(b.Wins, a.Wins),
+			cmp.Compare(a.Player, b.Player),
+		)
+	})
+}
+
+// SortByTime ranks players by how fast they win on average.
+func SortByTime(players []PlayerEntry) {
+	slices.SortFunc(players, func(a, b PlayerEntry) int {
+		return cmp.Or(
+			compareMissing(a.Wins == 0, b.Wins == 0),
+			cmp.Compare(a.AverageSeconds, b.AverageSeconds),
+			cmp.Compare(a.BestSeconds, b.BestSeconds),
+			cmp.Compare(a.Player, b.Player),
+		)
+	})
+}
+
+// SortByStreak ranks players by their current winning streak, then their
+// longest one.
+func SortByStreak(players []PlayerEntry) {
+	slices.SortFunc(players, func(a, b PlayerEntry) int {
+		return cmp.Or(
+			cmp.Compare(b.CurrentStreak, a.CurrentStreak),
+			cmp.Compare(b.MaxStreak, a.MaxStreak),
+			cmp.Compare(a.Player, b.Player),
+		)
+	})
+}
+
+// compareMissing sorts entries without data after the ones with.
+func compareMissing(aMissing, bMissing bool) int {
+	switch {
+	case aMissing == bMissing:
+		return 0
+	case aMissing:
+		return 1
+	default:
+		return -1
+	}
+}
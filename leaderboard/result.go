@@ -0,0 +1,71 @@
+package leaderboard
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/lang"
+)
+
+// Result is one player's daily puzzle as submitted to the server.
+type Result struct {
+	Player  string `json:"player"`
+	Day     int    `json:"day"`
+	Guesses int    `json:"guesses"`
+	Won     bool   `json:"won"`
+	Seconds int    `json:"seconds"`
+	// each language has its own daily answers, so its own board
+	Language string `json:"language"`
+	// hex HMAC-SHA256 of the other fields with the team key
+	Signature string `json:"signature"`
+}
+
+// FromRecord is the result of a daily game in the stats history, played in
+// language.
+func FromRecord(player, language string, r game.GameRecord) Result {
+	return Result{
+		Player:   player,
+		Day:      r.Daily,
+		Guesses:  len(r.Guesses),
+		Won:      r.Won,
+		Seconds:  r.Seconds,
+		Language: language,
+	}
+}
+
+// lang is the language of the result, english for results saved before
+// the language was sent.
+func (r Result) lang() string {
+	if r.Language == "" {
+		return lang.Default
+	}
+	return r.Language
+}
+
+// Sign returns the result with its signature set. the server only takes
+// results signed with the key it was started with, so only the team can
+// submit.
+func (r Result) Sign(key string) Result {
+	r.Signature = r.mac(key)
+	return r
+}
+
+// Verify checks the signature against key.
+func (r Result) Verify(key string) bool {
+	got, err := hex.DecodeString(r.Signature)
+	if err != nil {
+		return false
+	}
+	want, _ := hex.DecodeString(r.mac(key))
+
+	return hmac.Equal(got, want)
+}
+
+func (r Result) mac(key string) string {
+	h := hmac.New(sha256.New, []byte(key))
+	fmt.Fprintf(h, "%s\n%d\n%d\n%t\n%d\n%s", r.Player, r.Day, r.Guesses, r.Won, r.Seconds, r.Language)
+	return hex.EncodeToString(h.Sum(nil))
+}
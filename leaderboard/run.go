@@ -0,0 +1,112 @@
+package leaderboard
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/game"
+)
+
+const usage = `usage:
+  wordle leaderboard serve [-addr host:port] [-data file] [-key key]
+  wordle leaderboard show [-day N] [-by guesses|time|streak]`
+
+// Run handles the leaderboard subcommands: serve runs the server for the
+// team, show prints the board from the configured server.
+func Run(args []string, out io.Writer, cfg config.Config) error {
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+
+	switch args[0] {
+	case "serve":
+		return serve(args[1:], out, cfg)
+	case "show":
+		return show(args[1:], out, cfg)
+	}
+
+	return fmt.Errorf("unknown leaderboard command %q\n%s", args[0], usage)
+}
+
+func serve(args []string, out io.Writer, cfg config.Config) error {
+	flags := flag.NewFlagSet("leaderboard serve", flag.ContinueOnError)
+	addr := flags.String("addr", "localhost:8080", "address to listen on")
+	dataPath := flags.String("data", "leaderboard.json", "file the results are kept in")
+	key := flags.String("key", cfg.LeaderboardKey, "key results must be signed with, shared with the team")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *key == "" {
+		return errors.New("a key is needed to check submissions, set -key or leaderboard_key")
+	}
+
+	store, err := OpenStore(*dataPath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "leaderboard listening on http://%s, results in %s\n", *addr, *dataPath)
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           NewHandler(store, *key),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server.ListenAndServe()
+}
+
+func show(args []string, out io.Writer, cfg config.Config) error {
+	flags := flag.NewFlagSet("leaderboard show", flag.ContinueOnError)
+	day := flags.Int("day", game.DailyNumber(time.Now()), "daily puzzle number")
+	by := flags.String("by", "guesses", "how players are ranked: guesses, time or streak")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if cfg.Leaderboard == "" {
+		return errors.New("no leaderboard server configured, set leaderboard in the config")
+	}
+	sortPlayers, ok := Rankings[*by]
+	if !ok {
+		return fmt.Errorf("unknown ranking %q (want guesses, time or streak)", *by)
+	}
+
+	board, err := NewClient(cfg.Leaderboard, cfg.LeaderboardKey).Board(*day, cfg.Language)
+	if err != nil {
+		return err
+	}
+	sortPlayers(board.Players)
+
+	fmt.Fprintf(out, "daily puzzle #%d, %s\n", board.Day, cfg.Lang().Name)
+	if len(board.Daily) == 0 {
+		fmt.Fprintln(out, "no results yet")
+	}
+	for _, e := range board.Daily {
+		fmt.Fprintf(out, "%3d. %-16s %s %s\n", e.Rank, e.Player, Score(e.Guesses, e.Won), Duration(e.Seconds))
+	}
+
+	fmt.Fprintf(out, "\n%-16s %6s %5s %9s %9s %7s %7s\n", "player", "played", "wins", "avg tries", "avg time", "streak", "longest")
+	for _, p := range board.Players {
+		fmt.Fprintf(out, "%-16s %6d %5d %9.2f %9s %7d %7d\n",
+			p.Player, p.Played, p.Wins, p.AverageGuesses, Duration(int(p.AverageSeconds)), p.CurrentStreak, p.MaxStreak)
+	}
+
+	return nil
+}
+
+// Score is the guesses a game took, X when it was lost.
+func Score(guesses int, won bool) string {
+	if !won {
+		return "X"
+	}
+	return strconv.Itoa(guesses)
+}
+
+// Duration writes seconds as m:ss.
+func Duration(seconds int) string {
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
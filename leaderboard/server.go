@@ -0,0 +1,109 @@
+package leaderboard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/lang"
+)
+
+const (
+	maxPlayerName = 32
+	// results are accepted for the day before and after the server's, for
+	// players in other time zones
+	dayTolerance = 1
+)
+
+// NewHandler serves the leaderboard:
+//
+//	POST /results                  a signed Result as JSON
+//	GET  /leaderboard?day=N&lang=L the Board of day N in language L, today
+//	                               and english by default
+func NewHandler(store *Store, key string) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /results", func(w http.ResponseWriter, req *http.Request) {
+		var r Result
+		if err := json.NewDecoder(http.MaxBytesReader(w, req.Body, 1<<16)).Decode(&r); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("bad result: %w", err))
+			return
+		}
+		if !r.Verify(key) {
+			writeError(w, http.StatusUnauthorized, errors.New("bad signature"))
+			return
+		}
+		if err := validate(r, game.DailyNumber(time.Now())); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		err := store.Add(r)
+		switch {
+		case errors.Is(err, ErrDuplicate):
+			writeError(w, http.StatusConflict, err)
+		case err != nil:
+			writeError(w, http.StatusInternalServerError, err)
+		default:
+			w.WriteHeader(http.StatusCreated)
+		}
+	})
+
+	mux.HandleFunc("GET /leaderboard", func(w http.ResponseWriter, req *http.Request) {
+		day := game.DailyNumber(time.Now())
+		if s := req.URL.Query().Get("day"); s != "" {
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Errorf("day must be a number (got %q)", s))
+				return
+			}
+			day = n
+		}
+		language := lang.Default
+		if s := req.URL.Query().Get("lang"); s != "" {
+			if _, ok := lang.Get(s); !ok {
+				writeError(w, http.StatusBadRequest, fmt.Errorf("unknown language %q", s))
+				return
+			}
+			language = s
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Rank(store.Results(), day, language))
+	})
+
+	return mux
+}
+
+func validate(r Result, today int) error {
+	switch {
+	case r.Player == "" || utf8.RuneCountInString(r.Player) > maxPlayerName:
+		return fmt.Errorf("player must be 1 to %d characters", maxPlayerName)
+	case r.Day < today-dayTolerance || r.Day > today+dayTolerance:
+		return fmt.Errorf("day %d is not today's puzzle (%d)", r.Day, today)
+	case r.Guesses < 1 || r.Guesses > game.DailyMaxGuesses:
+		return fmt.Errorf("guesses must be between 1 and %d", game.DailyMaxGuesses)
+	case r.Seconds < 0:
+		return errors.New("seconds must not be negative")
+	case r.Language != "" && !knownLanguage(r.Language):
+		return fmt.Errorf("unknown language %q", r.Language)
+	}
+
+	return nil
+}
+
+func knownLanguage(code string) bool {
+	_, ok := lang.Get(code)
+	return ok
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
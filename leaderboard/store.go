@@ -0,0 +1,92 @@
+package leaderboard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"sync"
+)
+
+// ErrDuplicate is returned for a second result of a player on the same day
+// and language.
+var ErrDuplicate = errors.New("result for this day already submitted")
+
+// ErrAssisted is returned for games played with the assistant, which would
+// not be fair to compare.
+var ErrAssisted = errors.New("games played with the assistant are not submitted")
+
+// Store keeps the submitted results in a JSON file, rewriting the whole
+// file on every submission. a team submits a handful of results a day so
+// that is plenty.
+type Store struct {
+	path    string
+	mu      sync.Mutex
+	results []Result
+}
+
+// OpenStore loads the results saved at path, a missing file is an empty
+// store.
+func OpenStore(path string) (*Store, error) {
+	s := &Store{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.results); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// Add saves a result, unless the player already has one for its day and
+// language.
+func (s *Store) Add(r Result) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.results {
+		if existing.Player == r.Player && existing.Day == r.Day && existing.lang() == r.lang() {
+			return ErrDuplicate
+		}
+	}
+
+	results := append(slices.Clip(s.results), r)
+	if err := s.save(results); err != nil {
+		return err
+	}
+	s.results = results
+
+	return nil
+}
+
+// Results returns a copy of every saved result.
+func (s *Store) Results() []Result {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.results)
+}
+
+// save writes to a temporary file first so a crash never leaves half a
+// file behind.
+func (s *Store) save(results []Result) error {
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.path)
+}
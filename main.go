@@ -1,14 +1,158 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"os"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"koutaroyumiba/wordle/analyze"
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/lang"
+	"koutaroyumiba/wordle/leaderboard"
+	"koutaroyumiba/wordle/plain"
+	"koutaroyumiba/wordle/protocol"
+	"koutaroyumiba/wordle/replay"
+	"koutaroyumiba/wordle/transfer"
 	"koutaroyumiba/wordle/tui"
 )
 
 func main() {
-	p := tea.NewProgram(tui.InitialModel())
+	defaults := config.Default()
+	defaultPath, _ := config.DefaultPath()
+
+	configPath := flag.String("config", defaultPath, "path to the config file")
+	language := flag.String("lang", defaults.Language, "language: "+strings.Join(lang.Codes(), ", "))
+	wordLength := flag.Int("length", defaults.WordLength, "word length")
+	maxGuesses := flag.Int("guesses", defaults.MaxGuesses, "number of guesses allowed")
+	theme := flag.String("theme", defaults.Theme, "colour theme: dark, light or high-contrast")
+	layout := flag.String("layout", defaults.KeyboardLayout, "on-screen keyboard layout: qwerty, azerty, qwertz, dvorak, colemak, alphabetical or a custom one")
+	statsPath := flag.String("stats", defaults.StatsPath, "path to the stats file")
+	profile := flag.String("profile", defaults.Profile, "player profile, keeps its own stats and played answers")
+	exclude := flag.String("exclude", defaults.ExcludeAnswers, "file of answers never to pick, e.g. past official answers")
+	pick := flag.String("pick", defaults.Pick, "how answers are drawn: random, easy or hard")
+	difficulty := flag.String("difficulty", defaults.Difficulty, "what easy and hard picks are based on: frequency, history or rating")
+	tier := flag.String("tier", defaults.Tier, "only answers of a difficulty tier: any, easy, medium, hard or brutal")
+	rules := flag.String("rules", defaults.Rules, "feedback rules: "+strings.Join(game.RuleNames, ", "))
+	dictionary := flag.Bool("dictionary", defaults.EnforceDictionary, "only accept guesses from the word list")
+	assistant := flag.String("assistant", defaults.Assistant, "bot help during the game: off, count, candidates or suggestion")
+	hardMode := flag.Bool("hard", defaults.HardMode, "hard mode: keep greens in place and reuse every letter found")
+	animations := flag.Bool("animations", defaults.Animations, "animate tile reveals, invalid guesses and wins")
+
+	daily := flag.Bool("daily", false, "play today's daily puzzle first and submit it to the leaderboard")
+	reverse := flag.Bool("reverse", false, "play reverse wordle: find words that give the colours shown")
+	plainMode := flag.Bool("plain", false, "play in a line-oriented, screen-reader friendly mode")
+	protocolMode := flag.Bool("protocol", false, "let a bot play over stdin/stdout")
+	format := flag.String("format", protocol.FormatCode, "protocol reply format: code or json")
+	games := flag.Int("games", 1, "number of games to play in protocol mode")
+	word := flag.String("word", "", "fixed answer for protocol mode")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid config: %v\n", err)
+		os.Exit(1)
+	}
+
+	// flags given on the command line win over the config file
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "lang":
+			cfg.Language = *language
+		case "length":
+			cfg.WordLength = *wordLength
+		case "guesses":
+			cfg.MaxGuesses = *maxGuesses
+		case "theme":
+			cfg.Theme = *theme
+		case "layout":
+			cfg.KeyboardLayout = *layout
+		case "stats":
+			cfg.StatsPath = *statsPath
+		case "profile":
+			cfg.Profile = *profile
+		case "exclude":
+			cfg.ExcludeAnswers = *exclude
+		case "pick":
+			cfg.Pick = *pick
+		case "difficulty":
+			cfg.Difficulty = *difficulty
+		case "tier":
+			cfg.Tier = *tier
+		case "rules":
+			cfg.Rules = *rules
+		case "dictionary":
+			cfg.EnforceDictionary = *dictionary
+		case "hard":
+			cfg.HardMode = *hardMode
+		case "animations":
+			cfg.Animations = *animations
+		case "assistant":
+			cfg.Assistant = *assistant
+		}
+	})
+	cfg.Daily = *daily
+	cfg.Reverse = *reverse
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid flags: %v\n", err)
+		os.Exit(1)
+	}
+
+	// wordle [flags] stats export|import|merge ...
+	if flag.Arg(0) == "stats" {
+		if err := transfer.Run(flag.Args()[1:], os.Stdout, cfg.StatsFile()); err != nil {
+			fmt.Fprintf(os.Stderr, "stats: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// wordle [flags] analyze [-top N] [word ...]
+	if flag.Arg(0) == "analyze" {
+		if err := analyze.Run(flag.Args()[1:], os.Stdout, cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "analyze: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// wordle [flags] leaderboard serve|show ...
+	if flag.Arg(0) == "leaderboard" {
+		if err := leaderboard.Run(flag.Args()[1:], os.Stdout, cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "leaderboard: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// wordle [flags] replay [-game N] [-format cast|gif] [-o FILE]
+	if flag.Arg(0) == "replay" {
+		if err := replay.Run(flag.Args()[1:], os.Stdout, cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "replay: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *protocolMode {
+		opts := protocol.Options{Config: cfg, Format: *format, Games: *games, Word: *word}
+		if err := protocol.Run(os.Stdin, os.Stdout, opts); err != nil {
+			fmt.Fprintf(os.Stderr, "protocol error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *plainMode {
+		if err := plain.Run(os.Stdin, os.Stdout, cfg); err != nil {
+			fmt.Printf("Alas, there's been an error: %v\n", err)
+		}
+		return
+	}
+
+	p := tea.NewProgram(tui.InitialModel(cfg, *configPath), tea.WithMouseCellMotion())
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Alas, there's been an error: %v\n", err)
 	}
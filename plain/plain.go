@@ -0,0 +1,243 @@
+package plain
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/dict"
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/leaderboard"
+)
+
+var stateNames = map[game.CellState]string{
+	game.StateCorrect: "correct",
+	game.StatePresent: "present",
+	game.StateAbsent:  "absent",
+}
+
+// Run plays line-oriented games of wordle, reading guesses from in and
+// describing the feedback in words on out. nothing is redrawn, so it works
+// with screen readers, pipes and serial consoles.
+func Run(in io.Reader, out io.Writer, cfg config.Config) error {
+	scanner := bufio.NewScanner(in)
+
+	play := playGame
+	if cfg.Reverse {
+		play = playReverse
+	}
+
+	for {
+		quit, err := play(scanner, out, cfg)
+		if err != nil || quit {
+			return err
+		}
+
+		fmt.Fprintln(out, "Play again? (y/n)")
+		if !scanner.Scan() {
+			return scanner.Err()
+		}
+		answer := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if answer != "y" && answer != "yes" {
+			return nil
+		}
+	}
+}
+
+func playGame(scanner *bufio.Scanner, out io.Writer, cfg config.Config) (bool, error) {
+	wordle := cfg.NewGame()
+	language := cfg.Lang()
+	wordLength, maxGuesses := wordle.GetWordLength(), wordle.GetMaxGuesses()
+
+	fmt.Fprintf(out, "Terminal Wordle: guess the %d letter word in %d tries.\n", wordLength, maxGuesses)
+	if day := wordle.GetDaily(); day != 0 {
+		fmt.Fprintf(out, "This is daily puzzle #%d.\n", day)
+	}
+	rules := wordle.GetEvaluator()
+	if rules != game.Standard {
+		fmt.Fprintf(out, "Playing with %s rules: %s.\n", rules.Name(), game.RuleDescriptions[rules.Name()])
+	}
+	fmt.Fprintln(out, "Type a guess and press Enter. Commands: /known, /board, /stats, /help, /quit.")
+
+	guessNumber := 1
+	for {
+		fmt.Fprintf(out, "Guess %d of %d:\n", guessNumber, maxGuesses)
+		if !scanner.Scan() {
+			return true, scanner.Err()
+		}
+		input := language.Normalize(strings.TrimSpace(scanner.Text()))
+
+		switch input {
+		case "":
+			continue
+		case "/quit", "/exit":
+			fmt.Fprintf(out, "The word was %s.\n", wordle.GetAnswer())
+			return true, nil
+		case "/help", "?":
+			writeHelp(out)
+			continue
+		case "/known":
+			if rules != game.Standard {
+				fmt.Fprintf(out, "Letters are not tracked with %s rules.\n", rules.Name())
+				continue
+			}
+			writeKnown(out, wordle.GetKnown(), wordle.GetKnowledge(), language.Alphabet)
+			continue
+		case "/board":
+			writeBoard(out, wordle.GetGuesses(), guessNumber-1, rules.Positional())
+			continue
+		case "/stats":
+			writeStats(out, wordle.GetStats())
+			continue
+		}
+
+		if !language.ValidLetters(input) {
+			fmt.Fprintln(out, "Not accepted: only letters of the alphabet are allowed.")
+			continue
+		}
+		if ok, errMsg := wordle.ValidateWord(input); !ok {
+			fmt.Fprintf(out, "Not accepted: %s.\n", errMsg)
+			continue
+		}
+
+		finished, won := wordle.ApplyGuess(input)
+		row := wordle.GetGuesses()[guessNumber-1]
+		fmt.Fprintln(out, describeRow(row, rules.Positional()))
+		guessNumber++
+
+		if finished {
+			if won {
+				fmt.Fprintf(out, "Correct! You solved it in %d of %d guesses.\n", guessNumber-1, maxGuesses)
+			} else {
+				fmt.Fprintf(out, "Out of guesses. The word was %s.\n", wordle.GetAnswer())
+			}
+			if score, ok := game.AnswerRating(wordle.GetAnswer()); ok {
+				fmt.Fprintf(out, "Difficulty: %d/100 (%s).\n", score, game.TierOf(score))
+			}
+			if entry, ok := dict.Lookup(wordle.GetAnswer()); ok {
+				fmt.Fprintf(out, "%s: %s\n", entry.Word, entry)
+			}
+			writeStats(out, wordle.GetStats())
+			if submitted, err := leaderboard.SubmitGame(cfg, wordle); err != nil {
+				fmt.Fprintf(out, "Could not submit to the leaderboard: %v.\n", err)
+			} else if submitted {
+				fmt.Fprintln(out, "Submitted to the leaderboard.")
+			}
+			return false, nil
+		}
+
+		if rules == game.Standard {
+			writeKnown(out, wordle.GetKnown(), wordle.GetKnowledge(), language.Alphabet)
+		}
+	}
+}
+
+func writeHelp(out io.Writer) {
+	fmt.Fprintln(out, "Each letter of a guess is reported as correct (right place),")
+	fmt.Fprintln(out, "present (in the word, wrong place) or absent (not in the word).")
+	fmt.Fprintln(out, "Commands start with a slash so they are never taken for a guess.")
+	fmt.Fprintln(out, "/known: list letters found so far. /board: repeat all guesses.")
+	fmt.Fprintln(out, "/stats: show statistics. /quit: give up and reveal the word.")
+}
+
+// describeRow spells out the feedback for one guess, e.g.
+// "C correct, R present, A absent, N absent, E correct", or only the counts
+// when the feedback isn't tied to the letters, "1 correct, 2 present".
+func describeRow(cells []game.Cell, positional bool) string {
+	if !positional {
+		correct, present := game.Feedback(cells)
+		return fmt.Sprintf("%d correct, %d present", correct, present)
+	}
+
+	parts := make([]string, len(cells))
+	for i, c := range cells {
+		char, state := c.GetInfo()
+		parts[i] = fmt.Sprintf("%s %s", strings.ToUpper(string(char)), stateNames[state])
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func writeBoard(out io.Writer, guesses [][]game.Cell, played int, positional bool) {
+	if played == 0 {
+		fmt.Fprintln(out, "No guesses yet.")
+		return
+	}
+
+	for i := range played {
+		word := make([]rune, len(guesses[i]))
+		for j, c := range guesses[i] {
+			word[j], _ = c.GetInfo()
+		}
+		fmt.Fprintf(out, "Guess %d, %s: %s\n", i+1, string(word), describeRow(guesses[i], positional))
+	}
+}
+
+func writeKnown(out io.Writer, known map[rune]game.CellState, knowledge game.Knowledge, alphabet []rune) {
+	if len(known) == 0 {
+		fmt.Fprintln(out, "No letters known yet.")
+		return
+	}
+
+	byState := map[game.CellState][]string{}
+	for char, state := range known {
+		byState[state] = append(byState[state], strings.ToUpper(string(char)))
+	}
+
+	parts := []string{}
+	for _, state := range []game.CellState{game.StateCorrect, game.StatePresent, game.StateAbsent} {
+		letters := byState[state]
+		if len(letters) == 0 {
+			continue
+		}
+		sort.Strings(letters)
+		parts = append(parts, fmt.Sprintf("%s: %s", stateNames[state], strings.Join(letters, " ")))
+	}
+
+	// repeated letters, e.g. a yellow and a grey E mean exactly one E
+	counts := []string{}
+	for _, char := range alphabet {
+		least := knowledge.MinCount(char)
+		most, exact := knowledge.MaxCount(char)
+		switch {
+		case exact && least > 0 && most == least:
+			counts = append(counts, fmt.Sprintf("%s exactly %s", strings.ToUpper(string(char)), times(least)))
+		case least >= 2:
+			counts = append(counts, fmt.Sprintf("%s at least %s", strings.ToUpper(string(char)), times(least)))
+		}
+	}
+	if len(counts) > 0 {
+		parts = append(parts, fmt.Sprintf("counts: %s", strings.Join(counts, ", ")))
+	}
+
+	unused := []string{}
+	for _, char := range alphabet {
+		if _, ok := known[char]; !ok {
+			unused = append(unused, strings.ToUpper(string(char)))
+		}
+	}
+	if len(unused) > 0 {
+		parts = append(parts, fmt.Sprintf("unused: %s", strings.Join(unused, " ")))
+	}
+
+	fmt.Fprintf(out, "Known letters. %s.\n", strings.Join(parts, "; "))
+}
+
+func times(n int) string {
+	switch n {
+	case 1:
+		return "once"
+	case 2:
+		return "twice"
+	}
+
+	return fmt.Sprintf("%d times", n)
+}
+
+func writeStats(out io.Writer, stats game.Stats) {
+	fmt.Fprintf(out, "Games played %d, wins %d, win rate %.1f percent.\n", stats.GamesPlayed, stats.Wins, stats.WinRate())
+	fmt.Fprintf(out, "Current streak %d, max streak %d, average guesses %.2f.\n", stats.CurrentStreak, stats.MaxStreak, stats.AverageGuesses())
+}
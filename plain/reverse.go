@@ -0,0 +1,78 @@
+package plain
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+
+	"koutaroyumiba/wordle/bot"
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/game"
+)
+
+// playReverse plays one reverse wordle puzzle: the colours of each row are
+// read out and the player types a word that gives them against the answer.
+func playReverse(scanner *bufio.Scanner, out io.Writer, cfg config.Config) (bool, error) {
+	language := cfg.Lang()
+	answer := language.Answers[rand.Intn(len(language.Answers))]
+	puzzle := game.NewReversePuzzle(answer, language.Words, cfg.MaxGuesses-1)
+	wordleBot := bot.InitBotWithWords(cfg.WordLength, cfg.MaxGuesses, language.Words)
+	rows := len(puzzle.Patterns)
+
+	fmt.Fprintf(out, "Reverse Wordle: the answer is %s.\n", strings.ToUpper(answer))
+	fmt.Fprintf(out, "For each of the %d rows, type a word that gives its colours. Commands: /skip, /quit.\n", rows)
+
+	found := 0
+	for i, pattern := range puzzle.Patterns {
+		solutions := wordleBot.PatternSolutions(answer, pattern)
+		fmt.Fprintf(out, "Row %d of %d: %s (%s).\n", i+1, rows, describePattern(pattern), solutionCount(len(solutions)))
+
+		for {
+			if !scanner.Scan() {
+				return true, scanner.Err()
+			}
+			input := language.Normalize(strings.TrimSpace(scanner.Text()))
+
+			if input == "" {
+				continue
+			}
+			if input == "/quit" || input == "/exit" {
+				return true, nil
+			}
+			if input == "/skip" {
+				fmt.Fprintf(out, "Skipped, %s would have done it.\n", solutions[0])
+				break
+			}
+			if ok, reason := puzzle.Check(i, input); !ok {
+				fmt.Fprintf(out, "Not accepted: %s.\n", reason)
+				continue
+			}
+			fmt.Fprintln(out, "Correct!")
+			found++
+			break
+		}
+	}
+
+	fmt.Fprintf(out, "Puzzle done, %d of %d rows found.\n", found, rows)
+	return false, nil
+}
+
+func solutionCount(n int) string {
+	if n == 1 {
+		return "1 solution"
+	}
+	return fmt.Sprintf("%d solutions", n)
+}
+
+// describePattern reads a row of colours out letter by letter, e.g.
+// "absent, present, absent, correct, absent".
+func describePattern(pattern []game.CellState) string {
+	parts := make([]string, len(pattern))
+	for i, state := range pattern {
+		parts[i] = stateNames[state]
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,219 @@
+package protocol
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/lang"
+)
+
+const (
+	FormatCode = "code"
+	FormatJSON = "json"
+)
+
+// feedback codes, one character per letter of the guess
+var stateCodes = map[game.CellState]byte{
+	game.StateCorrect: 'G',
+	game.StatePresent: 'Y',
+	game.StateAbsent:  'B',
+}
+
+type Options struct {
+	Config config.Config
+	Format string // FormatCode or FormatJSON
+	Games  int    // number of games to play in a row
+	Word   string // fixed answer, random when empty
+}
+
+// message is a single reply line. in code format only the fields relevant
+// to its type are printed, in json format it is marshalled as is.
+type message struct {
+	Type       string   `json:"type"`
+	Game       int      `json:"game,omitempty"`
+	Games      int      `json:"games,omitempty"`
+	WordLength int      `json:"word_length,omitempty"`
+	MaxGuesses int      `json:"max_guesses,omitempty"`
+	Guess      string   `json:"guess,omitempty"`
+	Feedback   string   `json:"feedback,omitempty"`
+	Attempt    int      `json:"attempt,omitempty"`
+	Error      string   `json:"error,omitempty"`
+	Result     string   `json:"result,omitempty"`
+	Answer     string   `json:"answer,omitempty"`
+	Wins       *int     `json:"wins,omitempty"`
+	Average    *float64 `json:"average_guesses,omitempty"`
+}
+
+// Run plays games against a bot talking over in/out. every game starts with
+// a START line, each guess read from in is answered with a FEEDBACK or ERROR
+// line and the game ends with a RESULT line. a SUMMARY line follows the last
+// game. results are not written to the player's stats file.
+func Run(in io.Reader, out io.Writer, opts Options) error {
+	if opts.Format != FormatCode && opts.Format != FormatJSON {
+		return fmt.Errorf("unknown protocol format %q (want %s or %s)", opts.Format, FormatCode, FormatJSON)
+	}
+	if opts.Games < 1 {
+		opts.Games = 1
+	}
+	// bots play with the settings they are told about, never the daily
+	opts.Config.Daily = false
+	wordLength := opts.Config.WordLength
+	language := opts.Config.Lang()
+	opts.Word = language.Normalize(opts.Word)
+	if opts.Word != "" && utf8.RuneCountInString(opts.Word) != wordLength {
+		return fmt.Errorf("word %q must be %d letters", opts.Word, wordLength)
+	}
+
+	w := writer{out: bufio.NewWriter(out), format: opts.Format}
+	scanner := bufio.NewScanner(in)
+
+	wins, totalGuesses, played := 0, 0, 0
+	for gameNumber := 1; gameNumber <= opts.Games; gameNumber++ {
+		var wordle game.GameState
+		if opts.Word != "" {
+			wordle = opts.Config.NewGameWithWord(opts.Word)
+		} else {
+			wordle = opts.Config.NewGame()
+		}
+		wordle.DisableStats()
+
+		start := message{Type: "start", Game: gameNumber, Games: opts.Games, WordLength: wordle.GetWordLength(), MaxGuesses: wordle.GetMaxGuesses()}
+		if err := w.send(start); err != nil {
+			return err
+		}
+
+		result, attempts, err := playGame(scanner, w, &wordle, language)
+		if err != nil {
+			return err
+		}
+		played++
+		if result == "win" {
+			wins++
+			totalGuesses += attempts
+		}
+
+		if err := w.send(message{Type: "result", Game: gameNumber, Result: result, Attempt: attempts, Answer: wordle.GetAnswer()}); err != nil {
+			return err
+		}
+		if result == "quit" {
+			break
+		}
+	}
+
+	average := 0.0
+	if wins > 0 {
+		average = float64(totalGuesses) / float64(wins)
+	}
+
+	return w.send(message{Type: "summary", Games: played, Wins: &wins, Average: &average})
+}
+
+// playGame reads guesses until the game is over and returns "win", "loss" or
+// "quit" (input closed or the bot sent QUIT) along with the guesses used.
+func playGame(scanner *bufio.Scanner, w writer, wordle *game.GameState, language lang.Language) (string, int, error) {
+	attempts := 0
+	for {
+		if !scanner.Scan() {
+			return "quit", attempts, scanner.Err()
+		}
+		guess := language.Normalize(strings.TrimSpace(scanner.Text()))
+
+		if guess == "" {
+			if err := w.send(message{Type: "error", Error: "empty guess"}); err != nil {
+				return "", attempts, err
+			}
+			continue
+		}
+		if guess == "quit" {
+			return "quit", attempts, nil
+		}
+
+		if !language.ValidLetters(guess) {
+			if err := w.send(message{Type: "error", Guess: guess, Error: "invalid letters"}); err != nil {
+				return "", attempts, err
+			}
+			continue
+		}
+		if ok, errMsg := wordle.ValidateWord(guess); !ok {
+			if err := w.send(message{Type: "error", Guess: guess, Error: errMsg}); err != nil {
+				return "", attempts, err
+			}
+			continue
+		}
+
+		finished, won := wordle.ApplyGuess(guess)
+		attempts++
+
+		row := wordle.GetGuesses()[attempts-1]
+		if err := w.send(message{Type: "feedback", Guess: guess, Feedback: FeedbackCode(row), Attempt: attempts}); err != nil {
+			return "", attempts, err
+		}
+
+		if finished {
+			if won {
+				return "win", attempts, nil
+			}
+			return "loss", attempts, nil
+		}
+	}
+}
+
+// FeedbackCode encodes a guessed row as G (correct), Y (present) and
+// B (absent) characters, e.g. "BBGYB".
+func FeedbackCode(row []game.Cell) string {
+	code := make([]byte, len(row))
+	for i, c := range row {
+		_, state := c.GetInfo()
+		code[i] = stateCodes[state]
+	}
+
+	return string(code)
+}
+
+type writer struct {
+	out    *bufio.Writer
+	format string
+}
+
+func (w writer) send(m message) error {
+	var line string
+	if w.format == FormatJSON {
+		data, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+		line = string(data)
+	} else {
+		line = codeLine(m)
+	}
+
+	if _, err := fmt.Fprintln(w.out, line); err != nil {
+		return err
+	}
+
+	// bots wait on each reply, so never leave one sitting in the buffer
+	return w.out.Flush()
+}
+
+func codeLine(m message) string {
+	switch m.Type {
+	case "start":
+		return fmt.Sprintf("START %d/%d %d %d", m.Game, m.Games, m.WordLength, m.MaxGuesses)
+	case "feedback":
+		return fmt.Sprintf("FEEDBACK %s %d", m.Feedback, m.Attempt)
+	case "error":
+		return fmt.Sprintf("ERROR %s", m.Error)
+	case "result":
+		return fmt.Sprintf("RESULT %s %d %s", strings.ToUpper(m.Result), m.Attempt, m.Answer)
+	case "summary":
+		return fmt.Sprintf("SUMMARY %d %d %.2f", m.Games, *m.Wins, *m.Average)
+	}
+
+	return strings.ToUpper(m.Type)
+}
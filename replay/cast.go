@@ -0,0 +1,98 @@
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"koutaroyumiba/wordle/game"
+)
+
+// castHeader is the first line of an asciicast v2 file.
+type castHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title"`
+	Env       map[string]string `json:"env"`
+}
+
+// WriteCast writes the replay as an asciinema recording (asciicast v2), one
+// output event redrawing the screen per frame.
+func WriteCast(w io.Writer, rec game.GameRecord, maxGuesses int) error {
+	frames, err := Frames(rec, maxGuesses)
+	if err != nil {
+		return err
+	}
+	title := Title(rec, maxGuesses)
+	rows := len(frames[0].Rows)
+	tiles := utf8.RuneCountInString(rec.Answer)
+
+	enc := json.NewEncoder(w)
+	err = enc.Encode(castHeader{
+		Version:   2,
+		Width:     max(utf8.RuneCountInString(title), 4*tiles-1) + 4,
+		Height:    rows + 4,
+		Timestamp: rec.Date.Unix(),
+		Title:     title,
+		Env:       map[string]string{"TERM": "xterm-256color"},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, f := range frames {
+		if err := enc.Encode([]any{seconds(f.At), "o", renderScreen(title, f.Rows)}); err != nil {
+			return err
+		}
+	}
+
+	// an empty event keeps the final board up before the player stops
+	end := frames[len(frames)-1].At + holdEnd
+	return enc.Encode([]any{seconds(end), "o", ""})
+}
+
+// renderScreen clears the terminal and draws the board with true colour
+// escapes, tiles looking like the game's.
+func renderScreen(title string, rows [][]game.Cell) string {
+	var b strings.Builder
+	b.WriteString("\x1b[2J\x1b[H\r\n  \x1b[1m" + title + "\x1b[0m\r\n\r\n")
+	for _, row := range rows {
+		b.WriteString("  ")
+		for i, c := range row {
+			if i > 0 {
+				b.WriteString(" ")
+			}
+			b.WriteString(renderTile(c))
+		}
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
+
+func renderTile(c game.Cell) string {
+	char, state := c.GetInfo()
+	if char == 0 {
+		char = ' '
+	}
+
+	bg, ok := tileColours[state]
+	fg := letter
+	if !ok {
+		bg, fg = emptyBorder, typedBorder
+		if char != ' ' {
+			fg = letter
+		}
+	}
+	return fmt.Sprintf("\x1b[48;2;%d;%d;%dm\x1b[38;2;%d;%d;%dm %c \x1b[0m", bg.R, bg.G, bg.B, fg.R, fg.G, fg.B, char)
+}
+
+// seconds rounds to milliseconds, plenty for the player.
+func seconds(d time.Duration) float64 {
+	return math.Round(d.Seconds()*1000) / 1000
+}
@@ -0,0 +1,111 @@
+package replay
+
+import (
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+	"time"
+	"unicode"
+
+	"koutaroyumiba/wordle/game"
+)
+
+const (
+	tileSize = 52
+	tileGap  = 6
+	margin   = 16
+	border   = 2
+	// size of a glyph pixel
+	fontScale = 4
+)
+
+var gifPalette = color.Palette{background, emptyBorder, typedBorder, letter,
+	tileColours[game.StateCorrect], tileColours[game.StatePresent], tileColours[game.StateAbsent]}
+
+// WriteGIF writes the replay as an animated GIF that loops forever.
+func WriteGIF(w io.Writer, rec game.GameRecord, maxGuesses int) error {
+	frames, err := Frames(rec, maxGuesses)
+	if err != nil {
+		return err
+	}
+	rows, cols := len(frames[0].Rows), len(frames[0].Rows[0])
+	bounds := image.Rect(0, 0, 2*margin+cols*tileSize+(cols-1)*tileGap, 2*margin+rows*tileSize+(rows-1)*tileGap)
+
+	anim := &gif.GIF{}
+	for i, f := range frames {
+		next := f.At + holdEnd
+		if i+1 < len(frames) {
+			next = frames[i+1].At
+		}
+
+		img := image.NewPaletted(bounds, gifPalette)
+		for y, row := range f.Rows {
+			for x, c := range row {
+				drawTile(img, margin+x*(tileSize+tileGap), margin+y*(tileSize+tileGap), c)
+			}
+		}
+		anim.Image = append(anim.Image, img)
+		anim.Delay = append(anim.Delay, centiseconds(next-f.At))
+	}
+
+	return gif.EncodeAll(w, anim)
+}
+
+func drawTile(img *image.Paletted, x, y int, c game.Cell) {
+	char, state := c.GetInfo()
+	tile := image.Rect(x, y, x+tileSize, y+tileSize)
+
+	if fill, ok := tileColours[state]; ok {
+		fillRect(img, tile, fill)
+	} else {
+		outline := emptyBorder
+		if char != 0 {
+			outline = typedBorder
+		}
+		fillRect(img, tile, outline)
+		fillRect(img, tile.Inset(border), background)
+	}
+
+	if char != 0 {
+		drawLetter(img, x+(tileSize-5*fontScale)/2, y+(tileSize-7*fontScale)/2, unicode.ToUpper(char))
+	}
+}
+
+// drawLetter draws the glyph of char with its top left corner at x, y,
+// nothing for letters the font lacks.
+func drawLetter(img *image.Paletted, x, y int, char rune) {
+	if a, ok := accented[char]; ok {
+		drawBitmap(img, x, y-3*fontScale, a.mark[:])
+		char = a.base
+	}
+	if g, ok := glyphs[char]; ok {
+		drawBitmap(img, x, y, g[:])
+	}
+}
+
+func drawBitmap(img *image.Paletted, x, y int, bitmap []string) {
+	for row, line := range bitmap {
+		for col, pixel := range line {
+			if pixel == '#' {
+				px := image.Rect(x+col*fontScale, y+row*fontScale, x+(col+1)*fontScale, y+(row+1)*fontScale)
+				fillRect(img, px, letter)
+			}
+		}
+	}
+}
+
+func fillRect(img *image.Paletted, r image.Rectangle, c color.Color) {
+	index := uint8(img.Palette.Index(c))
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			img.SetColorIndex(x, y, index)
+		}
+	}
+}
+
+// centiseconds is a GIF frame delay, browsers treat delays under 2 as 10
+// so they are kept at 2 or more.
+func centiseconds(d time.Duration) int {
+	return max(int(d/(10*time.Millisecond)), 2)
+}
@@ -0,0 +1,114 @@
+package replay
+
+import (
+	"fmt"
+	"image/color"
+	"time"
+
+	"koutaroyumiba/wordle/game"
+)
+
+const (
+	// between typed letters and between revealed tiles
+	typingDelay = 120 * time.Millisecond
+	revealDelay = 150 * time.Millisecond
+	// between guesses of games recorded before the guess times were kept
+	guessPause = 2 * time.Second
+	// longer thinking is cut short so replays don't drag
+	maxPause = 3 * time.Second
+	// how long the final board stays up
+	holdEnd = 3 * time.Second
+)
+
+// colours of the dark theme
+var (
+	background  = color.RGBA{0x12, 0x12, 0x12, 0xff}
+	emptyBorder = color.RGBA{0x3a, 0x3a, 0x3c, 0xff}
+	typedBorder = color.RGBA{0x56, 0x57, 0x58, 0xff}
+	letter      = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	tileColours = map[game.CellState]color.RGBA{
+		game.StateCorrect: {0x6a, 0xaa, 0x64, 0xff},
+		game.StatePresent: {0xc9, 0xb4, 0x58, 0xff},
+		game.StateAbsent:  {0x78, 0x7c, 0x7e, 0xff},
+	}
+)
+
+// Frame is the board at one moment of a replay.
+type Frame struct {
+	At   time.Duration
+	Rows [][]game.Cell
+}
+
+// Frames plays a finished game back: the letters of each guess typed one at
+// a time, at the pace the guesses were made, then revealed tile by tile with
+// the feedback of the rules the game was played by. maxGuesses is the size
+// of the board for games recorded before their own limit was kept.
+func Frames(rec game.GameRecord, maxGuesses int) ([]Frame, error) {
+	if err := rec.Check(); err != nil {
+		return nil, err
+	}
+
+	rules, ok := game.RulesFor(rec.Rules)
+	if !ok {
+		rules = game.Standard
+	}
+	answer := []rune(rec.Answer)
+	board := make([][]game.Cell, max(rec.GuessLimit(maxGuesses), len(rec.Guesses)))
+	for i := range board {
+		board[i] = make([]game.Cell, len(answer))
+	}
+
+	frames := []Frame{{Rows: snapshot(board)}}
+	var t, last time.Duration
+	for i, guess := range rec.Guesses {
+		letters := []rune(guess)
+
+		pause := guessPause
+		if len(rec.Times) == len(rec.Guesses) {
+			at := time.Duration(rec.Times[i]) * time.Millisecond
+			pause, last = at-last, at
+		}
+		typing := time.Duration(len(letters)) * typingDelay
+		t += max(min(pause, maxPause)-typing, typingDelay)
+
+		for j, char := range letters {
+			board[i][j] = game.NewCell(char, game.StateEmpty)
+			frames = append(frames, Frame{At: t, Rows: snapshot(board)})
+			t += typingDelay
+		}
+
+		states := rules.Evaluate(answer, letters)
+		for j, char := range letters {
+			board[i][j] = game.NewCell(char, states[j])
+			frames = append(frames, Frame{At: t, Rows: snapshot(board)})
+			t += revealDelay
+		}
+	}
+
+	return frames, nil
+}
+
+// Title sums the game up the way it is shared, e.g. "Terminal Wordle 4/6".
+func Title(rec game.GameRecord, maxGuesses int) string {
+	maxGuesses = rec.GuessLimit(maxGuesses)
+	score := fmt.Sprintf("%d/%d", len(rec.Guesses), maxGuesses)
+	if !rec.Won {
+		score = fmt.Sprintf("X/%d", maxGuesses)
+	}
+	if rec.Rules != "" {
+		score += " (" + rec.Rules + ")"
+	}
+	if rec.Daily > 0 {
+		return fmt.Sprintf("Terminal Wordle #%d %s", rec.Daily, score)
+	}
+
+	return "Terminal Wordle " + score
+}
+
+func snapshot(board [][]game.Cell) [][]game.Cell {
+	rows := make([][]game.Cell, len(board))
+	for i, row := range board {
+		rows[i] = append([]game.Cell(nil), row...)
+	}
+	return rows
+}
@@ -0,0 +1,84 @@
+package replay
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/game"
+)
+
+const (
+	FormatCast = "cast"
+	FormatGIF  = "gif"
+)
+
+// Run exports a game from the stats history:
+//
+//	wordle replay [-game N] [-format cast|gif] [-o FILE]
+func Run(args []string, out io.Writer, cfg config.Config) error {
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	n := flags.Int("game", 1, "game to export, 1 is the last one played")
+	format := flags.String("format", "", "cast (asciinema) or gif, by default from the -o extension or cast")
+	output := flags.String("o", "", "file to write, by default named after the game")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	stats, err := game.ReadStatsFile(cfg.StatsFile())
+	if err != nil {
+		return err
+	}
+	recent := stats.RecentGames(*n)
+	if *n < 1 || len(recent) < *n {
+		return fmt.Errorf("no game %d, %d games in the history", *n, len(stats.History))
+	}
+	rec := recent[*n-1]
+
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(*output), ".")
+		if *format != FormatGIF {
+			*format = FormatCast
+		}
+	}
+	if *output == "" {
+		*output = FileName(rec, *format)
+	}
+
+	if err := Export(*output, *format, rec, cfg.MaxGuesses); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "wrote the replay of %s to %s\n", rec.Answer, *output)
+	return nil
+}
+
+// FileName names the replay of a game, e.g. wordle-2025-10-14-crane.gif.
+func FileName(rec game.GameRecord, format string) string {
+	return fmt.Sprintf("wordle-%s-%s.%s", rec.Date.Format("2006-01-02"), rec.Answer, format)
+}
+
+// Export writes the replay of a game to path as an asciicast or a GIF.
+func Export(path, format string, rec game.GameRecord, maxGuesses int) error {
+	write := WriteCast
+	switch format {
+	case FormatCast:
+	case FormatGIF:
+		write = WriteGIF
+	default:
+		return fmt.Errorf("unknown replay format %q (want cast or gif)", format)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f, rec, maxGuesses); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,25 @@
+package game_tests
+
+import (
+	"testing"
+
+	"koutaroyumiba/wordle/config"
+)
+
+func TestCustomLayoutOtherLanguage(t *testing.T) {
+	cfg := config.Default()
+	cfg.CustomLayouts = map[string][]string{"mine": {"qwfpbjluy", "arstgmneio", "zxcdvkh"}}
+	cfg.Language = "es"
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("an english layout that isn't selected broke spanish: %v", err)
+	}
+
+	cfg.KeyboardLayout = "mine"
+	if err := cfg.Validate(); err == nil {
+		t.Error("a selected layout without ñ was accepted for spanish")
+	}
+	cfg.Language = "en"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("selected english layout: %v", err)
+	}
+}
@@ -0,0 +1,20 @@
+package game_tests
+
+import (
+	"koutaroyumiba/wordle/data"
+	"koutaroyumiba/wordle/dict"
+	"testing"
+)
+
+func TestEveryAnswerIsDefined(t *testing.T) {
+	for _, answer := range data.ValidAnswers5 {
+		entry, ok := dict.Lookup(answer)
+		if !ok || entry.Definition == "" {
+			t.Errorf("%s has no definition", answer)
+		}
+	}
+
+	if _, ok := dict.Lookup("qqqqq"); ok {
+		t.Errorf("qqqqq should have no definition")
+	}
+}
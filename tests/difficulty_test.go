@@ -0,0 +1,101 @@
+package game_tests
+
+import (
+	"koutaroyumiba/wordle/data"
+	"koutaroyumiba/wordle/game"
+	"slices"
+	"testing"
+)
+
+func TestEveryAnswerIsRated(t *testing.T) {
+	for _, answer := range data.ValidAnswers5 {
+		score, ok := game.AnswerRating(answer)
+		if !ok || score < 0 || score > 100 {
+			t.Errorf("%s: rating %d, rated %v", answer, score, ok)
+		}
+	}
+}
+
+func TestPickByTier(t *testing.T) {
+	for _, tier := range game.Tiers {
+		for range 20 {
+			answer := game.PickAnswer(data.ValidAnswers5, nil, game.PoolOptions{Tier: tier})
+			if score, _ := game.AnswerRating(answer); game.TierOf(score) != tier {
+				t.Fatalf("%s pick %s is rated %d", tier, answer, score)
+			}
+		}
+	}
+}
+
+func playedGames(answers ...string) []game.GameRecord {
+	history := make([]game.GameRecord, len(answers))
+	for i, answer := range answers {
+		history[i] = game.GameRecord{Answer: answer}
+	}
+	return history
+}
+
+func TestPickUnplayedFirst(t *testing.T) {
+	answers := []string{"alpha", "bravo", "charl"}
+	tests := []struct {
+		history []game.GameRecord
+		exclude []string
+		allowed []string
+	}{
+		{playedGames("alpha", "bravo"), nil, []string{"charl"}},
+		// every answer came up once, so the pool starts over
+		{playedGames("alpha", "bravo", "charl"), nil, answers},
+		{playedGames("alpha", "bravo", "charl", "alpha"), nil, []string{"bravo", "charl"}},
+		{playedGames("alpha"), []string{"bravo"}, []string{"charl"}},
+	}
+
+	for _, tt := range tests {
+		for range 30 {
+			answer := game.PickAnswer(answers, tt.history, game.PoolOptions{Exclude: tt.exclude})
+			if !slices.Contains(tt.allowed, answer) {
+				t.Fatalf("history %v, excluding %v: picked %s, want one of %v", tt.history, tt.exclude, answer, tt.allowed)
+			}
+		}
+	}
+}
+
+func TestPickExcludedTier(t *testing.T) {
+	brutal := []string{}
+	for _, answer := range data.ValidAnswers5 {
+		if score, _ := game.AnswerRating(answer); game.TierOf(score) == game.TierBrutal {
+			brutal = append(brutal, answer)
+		}
+	}
+
+	for range 30 {
+		answer := game.PickAnswer(data.ValidAnswers5, nil, game.PoolOptions{Tier: game.TierBrutal, Exclude: brutal})
+		if slices.Contains(brutal, answer) {
+			t.Fatalf("picked excluded answer %s", answer)
+		}
+	}
+}
+
+func TestPickWeighting(t *testing.T) {
+	answers := []string{"easyy", "hardd"}
+	difficulty := func(answer string) float64 {
+		if answer == "hardd" {
+			return 1
+		}
+		return 0
+	}
+
+	for _, pick := range []string{game.PickEasy, game.PickHard} {
+		counts := map[string]int{}
+		for range 600 {
+			counts[game.PickAnswer(answers, nil, game.PoolOptions{Pick: pick, Difficulty: difficulty})]++
+		}
+		// five times as likely, so well over twice as often
+		favoured, other := counts["easyy"], counts["hardd"]
+		if pick == game.PickHard {
+			favoured, other = other, favoured
+		}
+		if favoured < 2*other {
+			t.Errorf("%s picks: %v", pick, counts)
+		}
+	}
+}
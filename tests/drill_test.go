@@ -0,0 +1,109 @@
+package game_tests
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"koutaroyumiba/wordle/bot"
+	"koutaroyumiba/wordle/data"
+	"koutaroyumiba/wordle/drill"
+	"koutaroyumiba/wordle/game"
+)
+
+func TestDrillCandidatesFitTheRows(t *testing.T) {
+	for _, kind := range drill.Types {
+		d, err := drill.New(kind, data.ValidAnswers5, 5, 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Contains(d.Candidates, d.Answer) {
+			t.Fatalf("%s: answer %s not among the candidates %v", kind, d.Answer, d.Candidates)
+		}
+		for _, candidate := range d.Candidates {
+			for _, row := range d.Rows {
+				guess := make([]rune, len(row))
+				want := make([]game.CellState, len(row))
+				for i, c := range row {
+					guess[i], want[i] = c.GetInfo()
+				}
+				if got := game.EvaluateGuess([]rune(candidate), guess); !slices.Equal(got, want) {
+					t.Errorf("%s: candidate %s doesn't fit %s", kind, candidate, string(guess))
+				}
+			}
+		}
+	}
+}
+
+func TestAnalysisOfRowsPlayed(t *testing.T) {
+	b := bot.InitBotWithWords(5, 6, data.ValidAnswers5)
+	rows := [][]game.Cell{}
+	for _, guess := range []string{"slate", "crony"} {
+		states := game.EvaluateGuess([]rune("crane"), []rune(guess))
+		row := []game.Cell{}
+		for i, char := range guess {
+			row = append(row, game.NewCell(char, states[i]))
+		}
+		rows = append(rows, row)
+	}
+
+	counts, words := b.Analysis(rows)
+	if len(counts) != 3 || counts[0] != len(data.ValidAnswers5) {
+		t.Fatalf("got counts %v, want the whole list and one entry per row after it", counts)
+	}
+	if want := b.Candidates(rows, len(rows)); !slices.Equal(words[2], want) || counts[2] != len(want) {
+		t.Errorf("words left after the last row %v, want %v", words[2], want)
+	}
+}
+
+func TestDrillBadInput(t *testing.T) {
+	if _, err := drill.New("spelling", data.ValidAnswers5, 5, 6); err == nil {
+		t.Error("unknown drill type accepted")
+	}
+	if _, err := drill.New(drill.TypeCandidates, []string{"crane"}, 5, 6); err == nil {
+		t.Error("drill made from one answer")
+	}
+}
+
+func TestDrillScores(t *testing.T) {
+	d, _ := drill.New(drill.TypeCandidates, data.ValidAnswers5, 5, 6)
+	if r := d.ScoreCandidates(d.Candidates); r.Score != 100 || len(r.Missed) != 0 {
+		t.Errorf("listing every candidate scored %+v", r)
+	}
+	r := d.ScoreCandidates(append([]string{"zzzzz"}, d.Candidates[1:]...))
+	if r.Score >= 100 || len(r.Missed) != 1 || !slices.Equal(r.Wrong, []string{"zzzzz"}) {
+		t.Errorf("one wrong, one missed scored %+v", r)
+	}
+
+	d, _ = drill.New(drill.TypeBestGuess, data.ValidAnswers5, 5, 6)
+	ranking := bot.InitBot(5, 6).RankGuesses(d.Candidates)
+	if r := d.ScoreGuess(ranking[0].Word, ranking); r.Score != 100 || r.Rank != 1 {
+		t.Errorf("the bot's pick scored %+v", r)
+	}
+	if r := d.ScoreGuess(ranking[len(ranking)-1].Word, ranking); r.Score >= 100 {
+		t.Errorf("the worst guess scored %+v", r)
+	}
+}
+
+func TestDrillProgress(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drills.json")
+	progress, err := drill.LoadProgress(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for score := range 30 {
+		progress.Add(drill.TypeCandidates, score)
+	}
+	if err := progress.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := drill.LoadProgress(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := loaded[drill.TypeCandidates]
+	if r.Played != 30 || r.Best != 29 || r.Average() != 14.5 || len(r.Recent) != 20 || r.Recent[19] != 29 {
+		t.Errorf("got %+v", r)
+	}
+}
@@ -2,15 +2,34 @@ package game_tests
 
 import (
 	"koutaroyumiba/wordle/game"
+	"path/filepath"
 	"testing"
 )
 
 var input_file string = "../data/wordle-answers-alphabetical.txt"
 
+// feedback writes a guessed row as - (absent), ^ (present) and x (correct).
+func feedback(gs game.GameState, row int) string {
+	codes := map[game.CellState]byte{
+		game.StateAbsent:  '-',
+		game.StatePresent: '^',
+		game.StateCorrect: 'x',
+	}
+
+	cells := gs.GetGuesses()[row]
+	out := make([]byte, len(cells))
+	for i, c := range cells {
+		_, state := c.GetInfo()
+		out[i] = codes[state]
+	}
+	return string(out)
+}
+
 func TestInit(t *testing.T) {
+	game.SetStatsPath(filepath.Join(t.TempDir(), "stats.json"))
 	words := game.ProcessFile(input_file)
 	t.Run("testing initialisation of game", func(t *testing.T) {
-		gs := game.InitGame(words)
+		gs := game.InitGameWithWords(5, 6, words, words)
 		if gs.GetAttempts() != 0 {
 			t.Errorf("what attempt do we start with?! %d", gs.GetAttempts())
 		}
@@ -18,17 +37,18 @@ func TestInit(t *testing.T) {
 }
 
 func TestWordleWordElate(t *testing.T) {
+	game.SetStatsPath(filepath.Join(t.TempDir(), "stats.json"))
 	t.Run("testing elate", func(t *testing.T) {
-		gs := game.InitGameWithWord("elate")
+		gs := game.InitGameWithWord(5, 6, "elate")
 		if gs.GetAttempts() != 0 {
 			t.Errorf("what attempt do we start with?! %d", gs.GetAttempts())
 		}
-		some, _ := gs.Guess("geese")
-		if some != "-^--x" {
+		gs.ApplyGuess("geese")
+		if some := feedback(gs, 0); some != "-^--x" {
 			t.Errorf("geese: should be -^--x, got %s", some)
 		}
-		some, _ = gs.Guess("teeth")
-		if some != "-^^x-" {
+		gs.ApplyGuess("teeth")
+		if some := feedback(gs, 1); some != "-^^x-" {
 			t.Errorf("teeth: should be -^^x-, got %s", some)
 		}
 	})
@@ -0,0 +1,111 @@
+package game_tests
+
+import (
+	"koutaroyumiba/wordle/data"
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/wordindex"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// a mix of words in the list and a miss
+var lookups = []string{"aahed", "crane", "elate", "zymic", "qqqqq"}
+
+// results go here so the benchmarked calls aren't optimised away
+var (
+	found   bool
+	matched []string
+)
+
+// what geese then teeth tell you about elate
+func elateConstraints() wordindex.Constraints {
+	k := game.NewKnowledge(5)
+	answer := []rune("elate")
+	for _, guess := range []string{"geese", "teeth"} {
+		k.Add([]rune(guess), game.EvaluateGuess(answer, []rune(guess)))
+	}
+	return k.Constraints()
+}
+
+func TestIndexFilterMatchesScan(t *testing.T) {
+	c := elateConstraints()
+	ix := wordindex.Shared(data.ValidWords5, 5)
+
+	want := []string{}
+	for _, word := range data.ValidWords5 {
+		if c.Matches([]rune(word)) {
+			want = append(want, word)
+		}
+	}
+
+	got := ix.Filter(c)
+	if !slices.Equal(got, want) {
+		t.Errorf("index found %d words, scanning found %d", len(got), len(want))
+	}
+	if !slices.Contains(got, "elate") {
+		t.Errorf("elate should fit its own feedback, got %v", got)
+	}
+}
+
+func TestIndexLookups(t *testing.T) {
+	ix := wordindex.Shared(data.ValidWords5, 5)
+	for _, word := range lookups {
+		if ix.Contains(word) != slices.Contains(data.ValidWords5, word) {
+			t.Errorf("Contains(%q) disagrees with the word list", word)
+		}
+	}
+
+	for _, word := range ix.WithPrefix("elat") {
+		if word[:4] != "elat" {
+			t.Errorf("WithPrefix(elat) returned %q", word)
+		}
+	}
+	if len(ix.WithPrefix("zzzz")) != 0 {
+		t.Errorf("WithPrefix(zzzz) should be empty")
+	}
+}
+
+func BenchmarkContainsSlice(b *testing.B) {
+	for b.Loop() {
+		for _, word := range lookups {
+			found = slices.Contains(data.ValidWords5, word)
+		}
+	}
+}
+
+func BenchmarkContainsIndex(b *testing.B) {
+	ix := wordindex.Shared(data.ValidWords5, 5)
+	for b.Loop() {
+		for _, word := range lookups {
+			found = ix.Contains(word)
+		}
+	}
+}
+
+func BenchmarkValidateWord(b *testing.B) {
+	game.SetStatsPath(filepath.Join(b.TempDir(), "stats.json"))
+	gs := game.InitGameWithWord(5, 6, "elate")
+	for b.Loop() {
+		for _, word := range lookups {
+			found, _ = gs.ValidateWord(word)
+		}
+	}
+}
+
+func BenchmarkFilterScan(b *testing.B) {
+	c := elateConstraints()
+	for b.Loop() {
+		for _, word := range data.ValidWords5 {
+			found = c.Matches([]rune(word))
+		}
+	}
+}
+
+func BenchmarkFilterIndex(b *testing.B) {
+	c := elateConstraints()
+	ix := wordindex.Shared(data.ValidWords5, 5)
+	for b.Loop() {
+		matched = ix.Filter(c)
+	}
+}
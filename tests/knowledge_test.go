@@ -0,0 +1,73 @@
+package game_tests
+
+import (
+	"path/filepath"
+	"testing"
+
+	"koutaroyumiba/wordle/game"
+)
+
+func TestKnowledgeCounts(t *testing.T) {
+	tests := []struct {
+		answer, guess string
+		char          rune
+		least, most   int
+		capped        bool
+		hint          string
+	}{
+		// a green and two grey Es: exactly one
+		{"crane", "eerie", 'e', 1, 1, true, "=1"},
+		// a yellow and two green Es, none grey: at least three
+		{"geese", "eerie", 'e', 3, 0, false, "≥3"},
+		// the third E is grey, so there are no more than the two coloured
+		{"speed", "geese", 'e', 2, 2, true, "=2"},
+		// one yellow tells nothing beyond the keyboard colour
+		{"crane", "eerie", 'r', 1, 0, false, ""},
+		{"crane", "stomp", 's', 0, 0, true, ""},
+	}
+
+	for _, tt := range tests {
+		k := game.NewKnowledge(5)
+		k.Add([]rune(tt.guess), game.EvaluateGuess([]rune(tt.answer), []rune(tt.guess)))
+
+		if got := k.MinCount(tt.char); got != tt.least {
+			t.Errorf("%s against %s: at least %d %c, want %d", tt.guess, tt.answer, got, tt.char, tt.least)
+		}
+		if most, capped := k.MaxCount(tt.char); capped != tt.capped || capped && most != tt.most {
+			t.Errorf("%s against %s: at most %d %c (%v), want %d (%v)", tt.guess, tt.answer, most, tt.char, capped, tt.most, tt.capped)
+		}
+		if got := k.CountHint(tt.char); got != tt.hint {
+			t.Errorf("%s against %s: hint for %c %q, want %q", tt.guess, tt.answer, tt.char, got, tt.hint)
+		}
+	}
+}
+
+func TestHardMode(t *testing.T) {
+	game.SetStatsPath(filepath.Join(t.TempDir(), "stats.json"))
+
+	tests := []struct {
+		answer, played, guess string
+		reason                string
+	}{
+		{"crane", "trace", "grace", ""},
+		// a known green moved off its spot
+		{"crane", "trace", "react", "2nd letter must be r"},
+		// the yellow C left out
+		{"crane", "trace", "grade", "guess must contain c"},
+		// three Es are known, two is not enough
+		{"geese", "eerie", "beige", "guess must contain e 3 times"},
+	}
+
+	for _, tt := range tests {
+		g := game.InitGameWithWord(5, 6, tt.answer)
+		g.DisableStats()
+		g.SetAllowDictionary(false)
+		g.SetHardMode(true)
+		g.ApplyGuess(tt.played)
+
+		ok, reason := g.ValidateWord(tt.guess)
+		if ok != (tt.reason == "") || reason != tt.reason {
+			t.Errorf("%s after %s: got %v %q, want %q", tt.guess, tt.played, ok, reason, tt.reason)
+		}
+	}
+}
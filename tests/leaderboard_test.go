@@ -0,0 +1,127 @@
+package game_tests
+
+import (
+	"errors"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/leaderboard"
+)
+
+func TestLeaderboardSubmissions(t *testing.T) {
+	store, err := leaderboard.OpenStore(filepath.Join(t.TempDir(), "leaderboard.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(leaderboard.NewHandler(store, "secret"))
+	defer server.Close()
+
+	today := game.DailyNumber(time.Now())
+	client := leaderboard.NewClient(server.URL, "secret")
+	results := []leaderboard.Result{
+		{Player: "ann", Day: today, Guesses: 4, Won: true, Seconds: 90},
+		{Player: "bob", Day: today, Guesses: 3, Won: true, Seconds: 200},
+		{Player: "cid", Day: today, Guesses: 4, Won: true, Seconds: 60},
+		{Player: "dee", Day: today, Guesses: 6, Won: false, Seconds: 30},
+	}
+	for _, r := range results {
+		if err := client.Submit(r); err != nil {
+			t.Fatalf("submit %s: %v", r.Player, err)
+		}
+	}
+
+	if err := client.Submit(results[0]); !errors.Is(err, leaderboard.ErrDuplicate) {
+		t.Errorf("second submit: got %v, want ErrDuplicate", err)
+	}
+	forged := leaderboard.NewClient(server.URL, "guess")
+	if err := forged.Submit(leaderboard.Result{Player: "eve", Day: today, Guesses: 1, Won: true}); err == nil {
+		t.Error("submit signed with the wrong key was accepted")
+	}
+
+	// the same day in another language is another puzzle
+	spanish := leaderboard.Result{Player: "ann", Day: today, Guesses: 2, Won: true, Seconds: 40, Language: "es"}
+	if err := client.Submit(spanish); err != nil {
+		t.Fatalf("submit in spanish: %v", err)
+	}
+
+	board, err := client.Board(today, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"bob", "cid", "ann", "dee"}; !slices.Equal(dailyOrder(board), want) {
+		t.Errorf("daily ranking %v, want %v", dailyOrder(board), want)
+	}
+	board, err = client.Board(today, "es")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"ann"}; !slices.Equal(dailyOrder(board), want) {
+		t.Errorf("spanish ranking %v, want %v", dailyOrder(board), want)
+	}
+	if _, err := client.Board(today, "xx"); err == nil {
+		t.Error("board of an unknown language was served")
+	}
+}
+
+func dailyOrder(board leaderboard.Board) []string {
+	order := []string{}
+	for _, e := range board.Daily {
+		order = append(order, e.Player)
+	}
+	return order
+}
+
+func TestLeaderboardStreaks(t *testing.T) {
+	results := []leaderboard.Result{}
+	for day, won := range []bool{true, true, false, true, true, true} {
+		results = append(results, leaderboard.Result{Player: "ann", Day: day + 1, Guesses: 4, Won: won, Seconds: 60})
+	}
+
+	// today's puzzle not played yet, the streak up to yesterday still counts
+	players := leaderboard.Rank(results, 7, "en").Players
+	if len(players) != 1 {
+		t.Fatalf("got %d players, want 1", len(players))
+	}
+	if p := players[0]; p.CurrentStreak != 3 || p.MaxStreak != 3 || p.Played != 6 || p.Wins != 5 {
+		t.Errorf("got %+v", p)
+	}
+
+	// later days are left out of old boards
+	if p := leaderboard.Rank(results, 2, "en").Players[0]; p.CurrentStreak != 2 || p.Played != 2 {
+		t.Errorf("day 2: got %+v", p)
+	}
+}
+
+func TestDailyFixedSettings(t *testing.T) {
+	store, err := leaderboard.OpenStore(filepath.Join(t.TempDir(), "leaderboard.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(leaderboard.NewHandler(store, "secret"))
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.StatsPath = filepath.Join(t.TempDir(), "stats.json")
+	cfg.Leaderboard, cfg.LeaderboardKey = server.URL, "secret"
+	cfg.MaxGuesses, cfg.EnforceDictionary, cfg.HardMode = 12, false, true
+	cfg.Daily = true
+
+	g := cfg.NewGame()
+	if g.GetMaxGuesses() != game.DailyMaxGuesses || g.GetWordLength() != game.DailyWordLength {
+		t.Errorf("daily board %dx%d", g.GetWordLength(), g.GetMaxGuesses())
+	}
+	if ok, _ := g.ValidateWord("zzzzz"); ok {
+		t.Error("daily accepted a word that isn't in the list")
+	}
+
+	g.MarkAssisted()
+	g.ApplyGuess(g.GetAnswer())
+	if submitted, err := leaderboard.SubmitGame(cfg, g); submitted || !errors.Is(err, leaderboard.ErrAssisted) {
+		t.Errorf("assisted game: got %v %v, want ErrAssisted", submitted, err)
+	}
+}
@@ -0,0 +1,54 @@
+package game_tests
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/protocol"
+)
+
+func protocolConfig(t *testing.T) config.Config {
+	cfg := config.Default()
+	cfg.StatsPath = filepath.Join(t.TempDir(), "stats.json")
+	return cfg
+}
+
+func TestProtocolGame(t *testing.T) {
+	var out bytes.Buffer
+	in := strings.NewReader("slate\nzz\nxyzzy\ncrane\n")
+	opts := protocol.Options{Config: protocolConfig(t), Format: protocol.FormatCode, Games: 1, Word: "crane"}
+	if err := protocol.Run(in, &out, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"START 1/1 5 6",
+		"FEEDBACK BBGBG 1",
+		"ERROR guess must be 5 letters",
+		"ERROR not in word list",
+		"FEEDBACK GGGGG 2",
+		"RESULT WIN 2 crane",
+		"SUMMARY 1 1 2.00",
+	}
+	if got := strings.Split(strings.TrimSpace(out.String()), "\n"); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestProtocolStartIgnoresDaily(t *testing.T) {
+	cfg := protocolConfig(t)
+	cfg.MaxGuesses = 8
+	cfg.Daily = true
+
+	var out bytes.Buffer
+	opts := protocol.Options{Config: cfg, Format: protocol.FormatCode, Games: 1}
+	if err := protocol.Run(strings.NewReader("quit\n"), &out, opts); err != nil {
+		t.Fatal(err)
+	}
+	if first, _, _ := strings.Cut(out.String(), "\n"); first != "START 1/1 5 8" {
+		t.Errorf("got %q, want the configured board", first)
+	}
+}
@@ -0,0 +1,112 @@
+package game_tests
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"image/gif"
+	"testing"
+	"time"
+
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/replay"
+)
+
+var replayGame = game.GameRecord{
+	Date:    time.Date(2025, 10, 14, 0, 0, 0, 0, time.UTC),
+	Answer:  "crane",
+	Guesses: []string{"slate", "crane"},
+	Won:     true,
+	Times:   []int{5000, 60000},
+}
+
+func TestReplayFrames(t *testing.T) {
+	frames, err := replay.Frames(replayGame, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the empty board, then each letter typed and revealed
+	if want := 1 + 2*5*2; len(frames) != want {
+		t.Fatalf("got %d frames, want %d", len(frames), want)
+	}
+	for i := 1; i < len(frames); i++ {
+		if frames[i].At <= frames[i-1].At {
+			t.Fatalf("frame %d at %v after %v", i, frames[i].At, frames[i-1].At)
+		}
+	}
+	// a minute of thinking is cut short
+	if end := frames[len(frames)-1].At; end > 10*time.Second {
+		t.Errorf("replay lasts %v", end)
+	}
+
+	last := frames[len(frames)-1].Rows
+	for _, c := range last[1] {
+		if _, state := c.GetInfo(); state != game.StateCorrect {
+			t.Errorf("last row %v not all correct", last[1])
+		}
+	}
+	if char, _ := last[2][0].GetInfo(); char != 0 || len(last) != 6 {
+		t.Errorf("rows after the game should be empty")
+	}
+}
+
+func TestReplayExports(t *testing.T) {
+	var cast bytes.Buffer
+	if err := replay.WriteCast(&cast, replayGame, 6); err != nil {
+		t.Fatal(err)
+	}
+	lines := bufio.NewScanner(&cast)
+	lines.Scan()
+	var header struct{ Version int }
+	if err := json.Unmarshal(lines.Bytes(), &header); err != nil || header.Version != 2 {
+		t.Fatalf("header %s: %v", lines.Text(), err)
+	}
+	events := 0
+	for lines.Scan() {
+		var event []any
+		if err := json.Unmarshal(lines.Bytes(), &event); err != nil || len(event) != 3 || event[1] != "o" {
+			t.Fatalf("event %s: %v", lines.Text(), err)
+		}
+		events++
+	}
+	frames, _ := replay.Frames(replayGame, 6)
+	if want := len(frames) + 1; events != want {
+		t.Errorf("got %d events, want %d", events, want)
+	}
+
+	var img bytes.Buffer
+	if err := replay.WriteGIF(&img, replayGame, 6); err != nil {
+		t.Fatal(err)
+	}
+	anim, err := gif.DecodeAll(&img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(anim.Image) != len(frames) {
+		t.Errorf("got %d GIF frames", len(anim.Image))
+	}
+}
+
+func TestReplayGuessLimit(t *testing.T) {
+	rec := replayGame
+	rec.MaxGuesses = 9
+	frames, err := replay.Frames(rec, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows := len(frames[0].Rows); rows != 9 {
+		t.Errorf("got %d rows, want the game's 9", rows)
+	}
+	if title := replay.Title(rec, 6); title != "Terminal Wordle 2/9" {
+		t.Errorf("got title %q", title)
+	}
+
+	rec.Guesses = []string{"slat", "crane"}
+	if _, err := replay.Frames(rec, 6); err == nil {
+		t.Error("a guess shorter than the answer was played back")
+	}
+	var buf bytes.Buffer
+	if err := replay.WriteGIF(&buf, rec, 6); err == nil {
+		t.Error("a guess shorter than the answer was exported")
+	}
+}
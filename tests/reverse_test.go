@@ -0,0 +1,36 @@
+package game_tests
+
+import (
+	"testing"
+
+	"koutaroyumiba/wordle/bot"
+	"koutaroyumiba/wordle/data"
+	"koutaroyumiba/wordle/game"
+)
+
+func TestReversePuzzle(t *testing.T) {
+	puzzle := game.NewReversePuzzle("crane", data.ValidWords5, 5)
+	if len(puzzle.Patterns) != 5 {
+		t.Fatalf("got %d rows, want 5", len(puzzle.Patterns))
+	}
+
+	wordleBot := bot.InitBot(5, 6)
+	for i, pattern := range puzzle.Patterns {
+		solutions := wordleBot.PatternSolutions("crane", pattern)
+		if len(solutions) == 0 {
+			t.Fatalf("row %d %v has no solutions", i, pattern)
+		}
+		for _, word := range solutions {
+			if ok, reason := puzzle.Check(i, word); !ok {
+				t.Errorf("row %d: solution %s rejected: %s", i, word, reason)
+			}
+		}
+	}
+
+	if ok, _ := puzzle.Check(0, "crane"); ok {
+		t.Error("the answer solved a row")
+	}
+	if ok, reason := puzzle.Check(0, "crnae"); ok || reason != "not in word list" {
+		t.Errorf("made up word: %v %q", ok, reason)
+	}
+}
@@ -0,0 +1,70 @@
+package game_tests
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"koutaroyumiba/wordle/data"
+	"koutaroyumiba/wordle/game"
+)
+
+func TestRulesAreConsistentWithTheirFeedback(t *testing.T) {
+	guesses := []string{"slate", "crane", "eerie", "mamma", "jazzy"}
+	for _, name := range game.RuleNames {
+		rules, _ := game.RulesFor(name)
+		for _, answer := range data.ValidAnswers5[:200] {
+			for _, guess := range guesses {
+				a, g := []rune(answer), []rune(guess)
+				feedback := rules.Evaluate(a, g)
+				if !slices.Equal(feedback, rules.Evaluate(a, g)) {
+					t.Fatalf("%s: %s for %s changes between calls", name, guess, answer)
+				}
+				if !rules.Consistent(a, g, feedback) {
+					t.Fatalf("%s: %s for %s gave %v, which it calls inconsistent", name, guess, answer, feedback)
+				}
+			}
+		}
+	}
+}
+
+func TestRuleVariants(t *testing.T) {
+	answer, guess := []rune("crane"), []rune("caret")
+	standard := game.EvaluateGuess(answer, guess)
+
+	fibble, _ := game.RulesFor(game.RulesFibble)
+	lies := 0
+	for i, s := range fibble.Evaluate(answer, guess) {
+		if s != standard[i] {
+			lies++
+		}
+	}
+	if lies != 1 {
+		t.Errorf("fibble told %d lies, want 1", lies)
+	}
+
+	count, _ := game.RulesFor(game.RulesCount)
+	want := []game.CellState{game.StateCorrect, game.StatePresent, game.StatePresent, game.StatePresent, game.StateAbsent}
+	if got := count.Evaluate(answer, guess); !slices.Equal(got, want) {
+		t.Errorf("count gave %v, want %v", got, want)
+	}
+
+	noYellow, _ := game.RulesFor(game.RulesNoYellow)
+	if slices.Contains(noYellow.Evaluate(answer, guess), game.StatePresent) {
+		t.Error("no-yellow showed a yellow")
+	}
+}
+
+func TestFibbleWinsOnTheAnswer(t *testing.T) {
+	game.SetStatsPath(filepath.Join(t.TempDir(), "stats.json"))
+	gs := game.InitGameWithWord(5, 6, "crane")
+	fibble, _ := game.RulesFor(game.RulesFibble)
+	gs.SetEvaluator(fibble)
+
+	if finished, won := gs.ApplyGuess("crane"); !finished || !won {
+		t.Errorf("guessing the answer: finished %v, won %v", finished, won)
+	}
+	if feedback(gs, 0) == "xxxxx" {
+		t.Error("fibble didn't lie about the winning guess")
+	}
+}
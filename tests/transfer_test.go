@@ -0,0 +1,89 @@
+package game_tests
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"koutaroyumiba/wordle/game"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	date := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	history := []game.GameRecord{
+		{ID: "a1", Date: date, Answer: "crane", Guesses: []string{"slate", "crane"}, Won: true, Daily: 42, Seconds: 31, Times: []int{12000, 31000}, MaxGuesses: 6},
+		{ID: "b2", Date: date, Answer: "night", Guesses: []string{"fight", "light"}, Assisted: true, Rules: game.RulesFibble},
+	}
+
+	var buf bytes.Buffer
+	if err := (game.Stats{History: history}).WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	stats, err := game.ReadCSV(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(stats.History, history) {
+		t.Errorf("got %+v, want %+v", stats.History, history)
+	}
+}
+
+func TestCSVOlderColumns(t *testing.T) {
+	file := "id,date,answer,guesses,won,assisted\n" +
+		"a1,2026-03-01T09:30:00Z,crane,slate crane,true,false\n"
+
+	stats, err := game.ReadCSV(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.History) != 1 || stats.History[0].Rules != "" || stats.History[0].Times != nil {
+		t.Errorf("got %+v", stats.History)
+	}
+}
+
+func TestCSVGuessLength(t *testing.T) {
+	file := "id,date,answer,guesses,won,assisted\n" +
+		"a1,2026-03-01T09:30:00Z,crane,slate cranes,false,false\n"
+
+	if _, err := game.ReadCSV(strings.NewReader(file)); err == nil {
+		t.Error("a guess longer than the answer was imported")
+	}
+}
+
+func TestMergeHistories(t *testing.T) {
+	mine, err := game.ReadJSON(strings.NewReader(`{"history": [
+		{"id": "a1", "date": "2026-03-01T09:00:00Z", "answer": "crane", "guesses": ["slate", "crane"], "won": true},
+		{"date": "2026-03-02T09:00:00Z", "answer": "night", "guesses": ["fight", "night"], "won": true}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	theirs, err := game.ReadJSON(strings.NewReader(`{"history": [
+		{"id": "a1", "date": "2026-03-01T09:00:00Z", "answer": "crane", "guesses": ["slate", "crane"], "won": true},
+		{"date": "2026-03-02T09:00:00Z", "answer": "night", "guesses": ["fight", "night"], "won": true},
+		{"date": "2026-03-03T09:00:00Z", "answer": "pious", "guesses": ["slate", "pious"], "won": true},
+		{"date": "2026-03-04T09:00:00Z", "answer": "lemon", "guesses": ["slate"], "won": false}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := mine.Merge(theirs)
+	if result.Duplicates != 2 || len(result.Added) != 2 {
+		t.Fatalf("got %d duplicates and %d added, want 2 and 2", result.Duplicates, len(result.Added))
+	}
+	if after := result.After; after.GamesPlayed != 4 || after.Wins != 3 || len(after.History) != 4 {
+		t.Errorf("got %d played, %d wins, %d in the history", after.GamesPlayed, after.Wins, len(after.History))
+	}
+}
+
+func TestReadJSONChecksGames(t *testing.T) {
+	_, err := game.ReadJSON(strings.NewReader(`{"history": [
+		{"date": "2026-03-01T09:00:00Z", "answer": "crane", "guesses": ["slat"], "won": false}
+	]}`))
+	if err == nil {
+		t.Error("a guess shorter than the answer was read")
+	}
+}
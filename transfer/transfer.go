@@ -0,0 +1,198 @@
+package transfer
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"koutaroyumiba/wordle/game"
+)
+
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+const usage = `usage:
+  wordle stats export [-format json|csv] [-o file]
+  wordle stats import [-format json|csv] [-dry-run] file
+  wordle stats merge [-dry-run] [-o file] first.json second.json`
+
+// Run handles the stats subcommands: export the stats file at statsPath,
+// import another file into it or merge two files into a third. out gets the
+// exported data or a summary of the changes.
+func Run(args []string, out io.Writer, statsPath string) error {
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+
+	switch args[0] {
+	case "export":
+		return export(args[1:], out, statsPath)
+	case "import":
+		return importFile(args[1:], out, statsPath)
+	case "merge":
+		return merge(args[1:], out)
+	}
+
+	return fmt.Errorf("unknown stats command %q\n%s", args[0], usage)
+}
+
+func export(args []string, out io.Writer, statsPath string) error {
+	flags := flag.NewFlagSet("stats export", flag.ContinueOnError)
+	format := flags.String("format", FormatJSON, "export format: json or csv")
+	output := flags.String("o", "", "file to write to instead of standard output")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *format != FormatJSON && *format != FormatCSV {
+		return fmt.Errorf("unknown format %q (want %s or %s)", *format, FormatJSON, FormatCSV)
+	}
+
+	stats, err := game.ReadStatsFile(statsPath)
+	if err != nil {
+		return err
+	}
+
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if *format == FormatCSV {
+		return stats.WriteCSV(out)
+	}
+	return stats.WriteJSON(out)
+}
+
+func importFile(args []string, out io.Writer, statsPath string) error {
+	flags := flag.NewFlagSet("stats import", flag.ContinueOnError)
+	format := flags.String("format", "", "format of the file: json or csv, guessed from the extension when empty")
+	dryRun := flags.Bool("dry-run", false, "show what would change without saving")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("import needs exactly one file")
+	}
+
+	imported, err := readFile(flags.Arg(0), *format)
+	if err != nil {
+		return err
+	}
+	current, err := game.ReadStatsFile(statsPath)
+	if err != nil {
+		return err
+	}
+
+	result := current.Merge(imported)
+	printDiff(out, result)
+	if *dryRun {
+		fmt.Fprintln(out, "dry run, nothing saved")
+		return nil
+	}
+
+	if err := result.After.WriteFile(statsPath); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "saved to %s\n", statsPath)
+	return nil
+}
+
+func merge(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("stats merge", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "show what would change without writing the result")
+	output := flags.String("o", "", "file to write the merged stats to, standard output when empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return errors.New("merge needs exactly two files")
+	}
+
+	first, err := readFile(flags.Arg(0), "")
+	if err != nil {
+		return err
+	}
+	second, err := readFile(flags.Arg(1), "")
+	if err != nil {
+		return err
+	}
+
+	result := first.Merge(second)
+	if *dryRun {
+		printDiff(out, result)
+		fmt.Fprintln(out, "dry run, nothing written")
+		return nil
+	}
+
+	if *output == "" {
+		return result.After.WriteJSON(out)
+	}
+	if err := result.After.WriteFile(*output); err != nil {
+		return err
+	}
+	printDiff(out, result)
+	fmt.Fprintf(out, "saved to %s\n", *output)
+	return nil
+}
+
+// readFile reads stats in the given format, or the one its extension
+// suggests.
+func readFile(path, format string) (game.Stats, error) {
+	if format == "" {
+		format = FormatJSON
+		if strings.EqualFold(filepath.Ext(path), ".csv") {
+			format = FormatCSV
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return game.Stats{}, err
+	}
+	defer f.Close()
+
+	var stats game.Stats
+	switch format {
+	case FormatJSON:
+		stats, err = game.ReadJSON(f)
+	case FormatCSV:
+		stats, err = game.ReadCSV(f)
+	default:
+		return game.Stats{}, fmt.Errorf("unknown format %q (want %s or %s)", format, FormatJSON, FormatCSV)
+	}
+	if err != nil {
+		return game.Stats{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return stats, nil
+}
+
+// printDiff lists the games a merge adds and how the totals change.
+func printDiff(out io.Writer, r game.MergeResult) {
+	for _, g := range r.Added {
+		result := "lost"
+		if g.Won {
+			result = fmt.Sprintf("won in %d", len(g.Guesses))
+		}
+		fmt.Fprintf(out, "+ %s  %s  %-8s %s\n", g.ID, g.Date.Format("2006-01-02"), g.Answer, result)
+	}
+	fmt.Fprintf(out, "%d new games, %d already present\n", len(r.Added), r.Duplicates)
+
+	before, after := r.Before, r.After
+	fmt.Fprintf(out, "games played    %d -> %d\n", before.GamesPlayed, after.GamesPlayed)
+	fmt.Fprintf(out, "wins            %d -> %d\n", before.Wins, after.Wins)
+	fmt.Fprintf(out, "win rate        %.1f%% -> %.1f%%\n", before.WinRate(), after.WinRate())
+	fmt.Fprintf(out, "current streak  %d -> %d\n", before.CurrentStreak, after.CurrentStreak)
+	fmt.Fprintf(out, "max streak      %d -> %d\n", before.MaxStreak, after.MaxStreak)
+	fmt.Fprintf(out, "avg guesses     %.2f -> %.2f\n", before.AverageGuesses(), after.AverageGuesses())
+}
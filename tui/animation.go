@@ -0,0 +1,151 @@
+package tui
+
+import (
+	"time"
+
+	"koutaroyumiba/wordle/game"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type animationKind int
+
+const (
+	animNone animationKind = iota
+	animReveal
+	animShake
+	animBounce
+)
+
+const (
+	revealInterval = 90 * time.Millisecond
+	shakeInterval  = 45 * time.Millisecond
+	bounceInterval = 100 * time.Millisecond
+)
+
+// how far the shaking row is pushed right on each frame
+var shakeOffsets = []int{2, 0, 2, 0, 1, 0, 1, 0}
+
+type animation struct {
+	kind  animationKind
+	row   int
+	frame int
+	// id tells ticks of an interrupted animation apart from the current one
+	id int
+}
+
+type animationTickMsg struct {
+	id int
+}
+
+// startAnimation replaces whatever was animating with kind on row and
+// schedules its first frame. it does nothing when animations are off.
+func (m *model) startAnimation(kind animationKind, row int) tea.Cmd {
+	if !m.cfg.Animations {
+		return nil
+	}
+
+	m.anim = animation{kind: kind, row: row, id: m.anim.id + 1}
+	return m.anim.tick()
+}
+
+func (a animation) tick() tea.Cmd {
+	interval := revealInterval
+	switch a.kind {
+	case animShake:
+		interval = shakeInterval
+	case animBounce:
+		interval = bounceInterval
+	}
+
+	id := a.id
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return animationTickMsg{id: id}
+	})
+}
+
+func (a animation) frames(wordLength int) int {
+	switch a.kind {
+	case animReveal:
+		// every letter is shown edge on for a frame before it is revealed
+		return wordLength * 2
+	case animShake:
+		return len(shakeOffsets)
+	case animBounce:
+		return wordLength + 1
+	}
+
+	return 0
+}
+
+func (m model) updateAnimation(msg animationTickMsg) (tea.Model, tea.Cmd) {
+	if msg.id != m.anim.id || m.anim.kind == animNone {
+		return m, nil
+	}
+
+	m.anim.frame++
+	if m.anim.frame < m.anim.frames(m.gameState.GetWordLength()) {
+		return m, m.anim.tick()
+	}
+
+	// a winning row bounces once it has been revealed
+	if m.anim.kind == animReveal && m.win {
+		return m, m.startAnimation(animBounce, m.anim.row)
+	}
+
+	m.anim.kind = animNone
+	return m, nil
+}
+
+// renderBoardRow draws row index of the board with st, applying the
+// running animation if it is on that row and marking the cursor on the row
+// being typed. the block is always one line
+// taller than a tile to leave a gap below the row.
+func (m model) renderBoardRow(st styles, cells []game.Cell, index int) string {
+	tiles := make([]string, len(cells))
+	for i, c := range cells {
+		tiles[i] = st.renderCell(c)
+	}
+	if index == m.gameState.GetAttempts() && !m.done && m.cursor < len(cells) {
+		char, _ := cells[m.cursor].GetInfo()
+		tiles[m.cursor] = st.cursor.Render(string(char))
+	}
+
+	animating := m.anim.kind != animNone && m.anim.row == index
+	offset, dropped := 0, -1
+	if animating {
+		switch m.anim.kind {
+		case animReveal:
+			for i, c := range cells {
+				char, _ := c.GetInfo()
+				switch {
+				case i == m.anim.frame/2 && m.anim.frame%2 == 1:
+					tiles[i] = st.renderTile('─', game.StateEmpty)
+				case i >= m.anim.frame/2:
+					tiles[i] = st.renderTile(char, game.StateEmpty)
+				}
+			}
+		case animShake:
+			offset = shakeOffsets[m.anim.frame]
+		case animBounce:
+			dropped = m.anim.frame
+		}
+	}
+
+	// the bouncing tile drops into the gap below the row
+	for i, tile := range tiles {
+		if i == dropped {
+			tiles[i] = "\n" + tile
+		} else {
+			tiles[i] = tile + "\n"
+		}
+	}
+
+	row := joinTiles(tiles)
+	if offset > 0 {
+		row = lipgloss.NewStyle().PaddingLeft(offset).Render(row)
+	}
+
+	return row
+}
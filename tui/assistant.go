@@ -0,0 +1,166 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"koutaroyumiba/wordle/bot"
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/game"
+)
+
+type assistLevel int
+
+const (
+	assistOff assistLevel = iota
+	// words left after each guess
+	assistCount
+	// plus the words that are still possible
+	assistCandidates
+	// plus the bot's pick for the next guess
+	assistSuggestion
+)
+
+// candidates are listed up to this many words
+const maxListedCandidates = 20
+
+func parseAssistLevel(name string) assistLevel {
+	if i := slices.Index(config.AssistantLevels, name); i >= 0 {
+		return assistLevel(i)
+	}
+
+	return assistOff
+}
+
+func (a assistLevel) String() string {
+	return config.AssistantLevels[a]
+}
+
+func (m model) bot() bot.WordleBot {
+	b := bot.InitBotWithWords(m.gameState.GetWordLength(), m.gameState.GetMaxGuesses(), m.cfg.Lang().Words)
+	return b.WithEvaluator(m.gameState.GetEvaluator())
+}
+
+// cycleAssistant moves to the next assistant level. turning it on during
+// a game flags the game as assisted.
+func (m model) cycleAssistant() model {
+	m.assist = (m.assist + 1) % assistLevel(len(config.AssistantLevels))
+	if m.assist != assistOff {
+		m.gameState.MarkAssisted()
+	}
+
+	return m.refreshCandidates().refreshSuggestion()
+}
+
+// refreshSuggestion works out the bot's next guess when the suggestion is
+// on display. it is kept in the model since it is too slow to redo on
+// every render.
+func (m model) refreshSuggestion() model {
+	m.suggestion = ""
+	if m.assist < assistSuggestion || m.done {
+		return m
+	}
+
+	b := m.bot()
+	candidates := b.Candidates(m.gameState.GetGuesses(), m.gameState.GetAttempts())
+	m.suggestion = b.Suggest(candidates)
+
+	return m
+}
+
+// refreshAnalysis works out how many words were left after each guess. like
+// the suggestion it only changes with the guesses, so it isn't redone on
+// every render and animation frame.
+func (m model) refreshAnalysis() model {
+	m.wordsLeft, m.leftWords = m.bot().Analysis(m.gameState.GetGuesses())
+	return m.refreshCandidates()
+}
+
+// refreshCandidates works out the words listed below the board when they
+// are on display, again whenever the guesses, the typed letters or the
+// assistant level change rather than on every render.
+func (m model) refreshCandidates() model {
+	m.candidates = nil
+	if m.assist < assistCandidates || m.done {
+		return m
+	}
+
+	m.candidates = m.typedCandidates()
+	return m
+}
+
+// assistantRow is the text next to board row i.
+func (m model) assistantRow(i int, length []int, words [][]string) string {
+	if !m.done && (m.assist == assistOff || i > m.gameState.GetAttempts()) {
+		return ""
+	}
+
+	var line strings.Builder
+	line.WriteString(fmt.Sprintf("no. of words left: %d", length[i]))
+	if m.done && len(words[i]) > 0 && len(words[i]) < 8 {
+		line.WriteString(" [")
+		for _, word := range words[i] {
+			line.WriteString(fmt.Sprintf(" %s ", word))
+		}
+		line.WriteString("]")
+	}
+
+	return line.String()
+}
+
+// viewHints lists the remaining candidates and the suggested guess below
+// the board, depending on the assistant level.
+func (m model) viewHints() string {
+	if m.done || m.assist < assistCandidates {
+		return ""
+	}
+
+	var b strings.Builder
+	candidates := m.candidates
+	listed := candidates[:min(len(candidates), maxListedCandidates)]
+	if len(m.current) > 0 {
+		b.WriteString(fmt.Sprintf("Possible words starting with %s (%d): %s", strings.ToUpper(string(m.current)), len(candidates), strings.Join(listed, " ")))
+	} else {
+		b.WriteString(fmt.Sprintf("Possible words (%d): %s", len(candidates), strings.Join(listed, " ")))
+	}
+	if len(candidates) > len(listed) {
+		b.WriteString(" ...")
+	}
+
+	if m.assist >= assistSuggestion && m.suggestion != "" {
+		b.WriteString(fmt.Sprintf("\nSuggested guess: %s", m.suggestion))
+	}
+
+	return b.String()
+}
+
+// typedCandidates are the words that fit the feedback so far and start with
+// the letters typed into the current row, so the list narrows while typing.
+func (m model) typedCandidates() []string {
+	b := m.bot()
+	if len(m.current) == 0 {
+		return b.Candidates(m.gameState.GetGuesses(), m.gameState.GetAttempts())
+	}
+
+	// other rules don't build up knowledge, so filter the candidates instead
+	if m.gameState.GetEvaluator() != game.Standard {
+		typed := []string{}
+		for _, word := range b.Candidates(m.gameState.GetGuesses(), m.gameState.GetAttempts()) {
+			if strings.HasPrefix(word, string(m.current)) {
+				typed = append(typed, word)
+			}
+		}
+		return typed
+	}
+
+	c := m.gameState.GetKnowledge().Constraints()
+	for i, char := range m.current {
+		if i >= len(c.Fixed) || c.Fixed[i] != 0 && c.Fixed[i] != char {
+			return []string{}
+		}
+		c.Fixed[i] = char
+	}
+
+	return b.Filter(c)
+}
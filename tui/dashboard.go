@@ -0,0 +1,293 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"koutaroyumiba/wordle/dict"
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/replay"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	dashboardWeeks = 12
+	dashboardTop   = 5
+	barWidth       = 30
+	// games listed in the recent games browser
+	dashboardRecent = 8
+)
+
+var (
+	sectionStyle = lipgloss.NewStyle().Bold(true).Underline(true)
+	dimStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("#555555"))
+	sparkLevels  = []rune("▁▂▃▄▅▆▇█")
+
+	definitionStyle = lipgloss.NewStyle().Italic(true)
+)
+
+func (m model) updateDashboard(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		if size, ok := msg.(tea.WindowSizeMsg); ok {
+			return m.updateWindowSize(size)
+		}
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "q", "ctrl+t":
+		m.screen = screenGame
+		m.exported = ""
+		return m, tea.ClearScreen
+	case "up", "k":
+		m.historyCursor = max(m.historyCursor-1, 0)
+	case "down", "j":
+		recent := len(m.gameState.GetStats().RecentGames(dashboardRecent))
+		m.historyCursor = max(min(m.historyCursor+1, recent-1), 0)
+	case "e":
+		m.exported = m.exportReplay()
+	}
+
+	return m, nil
+}
+
+func (m model) viewDashboard() string {
+	stats := m.gameState.GetStats()
+	now := time.Now()
+
+	summary := fmt.Sprintf("Played %d · Win %.0f%% · Streak %d (max %d) · Avg guesses %.2f · Assisted %d",
+		stats.GamesPlayed, stats.WinRate(), stats.CurrentStreak, stats.MaxStreak, stats.AverageGuesses(), stats.AssistedGames())
+
+	left := lipgloss.JoinVertical(lipgloss.Left,
+		m.viewDistribution(stats), "",
+		viewTrends(stats.Weekly(now, dashboardWeeks), m.cfg.MaxGuesses), "",
+		m.viewRecent(stats.RecentGames(dashboardRecent)),
+	)
+	right := lipgloss.JoinVertical(lipgloss.Left,
+		m.viewCalendar(stats, now), "",
+		viewHardest(stats.HardestAnswers(dashboardTop)), "",
+		viewStartingWords(stats.StartingWords(dashboardTop)),
+	)
+
+	body := lipgloss.JoinHorizontal(lipgloss.Top, left, "    ", right)
+	if m.width > 0 && lipgloss.Width(body) > m.width {
+		body = lipgloss.JoinVertical(lipgloss.Left, left, "", right)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		headerStyle.Render("Statistics (↑/↓: browse recent games, e: export replay, esc to go back)"),
+		summary, "",
+		body,
+		m.exported,
+	)
+}
+
+// exportReplay writes the picked recent game as an asciicast and a GIF to
+// the current directory and says where.
+func (m model) exportReplay() string {
+	recent := m.gameState.GetStats().RecentGames(dashboardRecent)
+	if len(recent) == 0 {
+		return "no games to export yet"
+	}
+
+	rec := recent[min(m.historyCursor, len(recent)-1)]
+	files := []string{}
+	for _, format := range []string{replay.FormatCast, replay.FormatGIF} {
+		name := replay.FileName(rec, format)
+		if err := replay.Export(name, format, rec, m.cfg.MaxGuesses); err != nil {
+			return fmt.Sprintf("could not export the replay: %v", err)
+		}
+		files = append(files, name)
+	}
+
+	return "replay saved to " + strings.Join(files, " and ")
+}
+
+// viewDistribution draws the guess distribution, highlighting the bar the
+// last game landed in.
+func (m model) viewDistribution(stats game.Stats) string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render("Guess distribution"))
+	b.WriteString("\n")
+
+	lastRow := -1
+	if last, ok := stats.LastGame(); ok && last.Won {
+		lastRow = len(last.Guesses)
+	}
+
+	most := 0
+	for _, count := range stats.GuessFrequency {
+		most = max(most, count)
+	}
+
+	for i := 1; i <= m.cfg.MaxGuesses; i++ {
+		count := stats.GuessFrequency[i]
+		width := 1
+		if most > 0 {
+			width = max(1, count*barWidth/most)
+		}
+
+		style := m.styles.absent
+		if i == lastRow {
+			style = m.styles.correct
+		}
+		bar := style.Padding(0).Render(strings.Repeat(" ", width-1) + fmt.Sprint(count))
+		b.WriteString(fmt.Sprintf("%d %s\n", i, bar))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func viewTrends(weeks []game.WeekSummary, maxGuesses int) string {
+	winRates := make([]float64, len(weeks))
+	averages := make([]float64, len(weeks))
+	played := make([]bool, len(weeks))
+	for i, w := range weeks {
+		winRates[i] = w.WinRate
+		averages[i] = w.AverageGuess
+		played[i] = w.Played > 0
+	}
+
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render(fmt.Sprintf("Last %d weeks", len(weeks))))
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("Win rate     %s\n", sparkline(winRates, played, 0, 100)))
+	b.WriteString(fmt.Sprintf("Avg guesses  %s\n", sparkline(averages, played, 1, float64(maxGuesses))))
+	b.WriteString(dimStyle.Render("             oldest → this week"))
+
+	return b.String()
+}
+
+// sparkline draws one block per value scaled between lo and hi, with a dot
+// for weeks without games.
+func sparkline(values []float64, present []bool, lo, hi float64) string {
+	var b strings.Builder
+	for i, v := range values {
+		if !present[i] {
+			b.WriteString(dimStyle.Render("·"))
+			continue
+		}
+		level := 0
+		if hi > lo {
+			level = int((v - lo) / (hi - lo) * float64(len(sparkLevels)-1))
+		}
+		level = min(max(level, 0), len(sparkLevels)-1)
+		b.WriteRune(sparkLevels[level])
+	}
+
+	return b.String()
+}
+
+// viewCalendar is a heatmap of the last weeks, one column per week and one
+// row per weekday.
+func (m model) viewCalendar(stats game.Stats, now time.Time) string {
+	// start on the monday of the oldest week so the rows line up with weekdays
+	sinceMonday := (int(now.Weekday()) + 6) % 7
+	calendar := stats.Calendar(now, 7*(dashboardWeeks-1)+sinceMonday+1)
+
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render("Activity"))
+	b.WriteString("\n")
+
+	for weekday, label := range []string{"Mon", "", "Wed", "", "Fri", "", "Sun"} {
+		b.WriteString(fmt.Sprintf("%-4s", label))
+		for week := range dashboardWeeks {
+			i := week*7 + weekday
+			if i >= len(calendar) {
+				continue
+			}
+			day := calendar[i]
+			switch {
+			case day.Wins > 0:
+				b.WriteString(m.styles.correct.Padding(0).Render("■"))
+			case day.Played > 0:
+				b.WriteString(m.styles.absent.Padding(0).Render("■"))
+			default:
+				b.WriteString(dimStyle.Render("·"))
+			}
+			b.WriteString(" ")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(dimStyle.Render("    ■ won  ■ lost  · no games"))
+
+	return b.String()
+}
+
+func viewHardest(records []game.GameRecord) string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render("Hardest answers"))
+	b.WriteString("\n")
+
+	if len(records) == 0 {
+		b.WriteString(dimStyle.Render("no games yet"))
+		return b.String()
+	}
+	for _, r := range records {
+		result := fmt.Sprintf("%d guesses", len(r.Guesses))
+		if !r.Won {
+			result = "lost"
+		}
+		b.WriteString(fmt.Sprintf("%-8s %s\n", r.Answer, result))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// viewRecent lists the last games with the definition of the picked one's
+// answer underneath.
+func (m model) viewRecent(records []game.GameRecord) string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render("Recent games"))
+	b.WriteString("\n")
+
+	if len(records) == 0 {
+		b.WriteString(dimStyle.Render("no games yet"))
+		return b.String()
+	}
+	for i, r := range records {
+		limit := r.GuessLimit(m.cfg.MaxGuesses)
+		result := fmt.Sprintf("%d/%d", len(r.Guesses), limit)
+		if !r.Won {
+			result = fmt.Sprintf("X/%d", limit)
+		}
+		line := fmt.Sprintf("%s %-6s %s", r.Date.Format("Jan 02"), r.Answer, result)
+		if i == m.historyCursor {
+			b.WriteString(sectionStyle.Render("> " + line))
+		} else {
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+
+	picked := records[min(m.historyCursor, len(records)-1)]
+	if entry, ok := dict.Lookup(picked.Answer); ok {
+		b.WriteString(definitionStyle.Width(barWidth + 10).Render(picked.Answer + ": " + entry.String()))
+	} else {
+		b.WriteString(dimStyle.Render("no definition for " + picked.Answer))
+	}
+
+	return b.String()
+}
+
+func viewStartingWords(words []game.WordCount) string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render("Favourite openers"))
+	b.WriteString("\n")
+
+	if len(words) == 0 {
+		b.WriteString(dimStyle.Render("no games yet"))
+		return b.String()
+	}
+	for _, w := range words {
+		b.WriteString(fmt.Sprintf("%-8s %d\n", w.Word, w.Count))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,251 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"koutaroyumiba/wordle/bot"
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/drill"
+	"koutaroyumiba/wordle/wordindex"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var drillTitles = map[string]string{
+	drill.TypeCandidates: "List the candidates",
+	drill.TypeBestGuess:  "Best next guess",
+}
+
+var drillInstructions = map[string]string{
+	drill.TypeCandidates: "List every answer that still fits, separated by spaces.",
+	drill.TypeBestGuess:  "Type the guess that tells you the most about the answer.",
+}
+
+// drillModel trains reading the board: each drill is a partial game, and
+// the player lists the answers left or picks the next guess and is scored
+// against the bot.
+type drillModel struct {
+	cfg      config.Config
+	kind     int
+	drill    drill.Drill
+	input    []rune
+	result   *drill.Result
+	progress drill.Progress
+	message  string
+}
+
+func newDrills(cfg config.Config) drillModel {
+	d := drillModel{cfg: cfg}
+	progress, err := drill.LoadProgress(cfg.DrillsFile())
+	if err != nil {
+		progress = drill.Progress{}
+		d.message = fmt.Sprintf("could not read the drill progress: %v", err)
+	}
+	d.progress = progress
+
+	return d.next()
+}
+
+// next starts another drill of the current type.
+func (d drillModel) next() drillModel {
+	next, err := drill.New(drill.Types[d.kind], d.cfg.Lang().Answers, d.cfg.WordLength, d.cfg.MaxGuesses)
+	if err != nil {
+		d.message = fmt.Sprintf("could not make a drill: %v", err)
+		return d
+	}
+	d.drill = next
+	d.input = nil
+	d.result = nil
+	return d
+}
+
+// Update returns false once the player leaves the drills.
+func (d drillModel) Update(msg tea.KeyMsg) (drillModel, bool) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		return d, false
+	case tea.KeyLeft, tea.KeyRight:
+		step := 1
+		if msg.Type == tea.KeyLeft {
+			step = -1
+		}
+		d.kind = (d.kind + step + len(drill.Types)) % len(drill.Types)
+		d.message = ""
+		return d.next(), true
+	case tea.KeyCtrlN:
+		d.message = ""
+		return d.next(), true
+	case tea.KeyEnter:
+		if d.result != nil {
+			d.message = ""
+			return d.next(), true
+		}
+		return d.submit(), true
+	case tea.KeyBackspace:
+		if len(d.input) > 0 && d.result == nil {
+			d.input = d.input[:len(d.input)-1]
+		}
+	case tea.KeySpace, tea.KeyRunes:
+		if d.result != nil {
+			return d, true
+		}
+		language := d.cfg.Lang()
+		for _, char := range language.Normalize(string(msg.Runes)) {
+			switch {
+			case char == ' ' && d.drill.Type == drill.TypeCandidates:
+				d.input = append(d.input, char)
+			case language.InAlphabet(char):
+				if d.drill.Type == drill.TypeCandidates || len(d.input) < d.cfg.WordLength {
+					d.input = append(d.input, char)
+				}
+			}
+		}
+	}
+
+	return d, true
+}
+
+func (d drillModel) submit() drillModel {
+	if d.drill.Answer == "" {
+		return d
+	}
+	d.message = ""
+	var result drill.Result
+	switch d.drill.Type {
+	case drill.TypeCandidates:
+		listed := slices.Compact(slices.Sorted(slices.Values(strings.Fields(string(d.input)))))
+		if len(listed) == 0 {
+			d.message = "type the words that are left first"
+			return d
+		}
+		result = d.drill.ScoreCandidates(listed)
+	case drill.TypeBestGuess:
+		guess := string(d.input)
+		if !wordindex.Shared(d.cfg.Lang().Words, d.cfg.WordLength).Contains(guess) {
+			d.message = "not in word list"
+			return d
+		}
+		b := bot.InitBotWithWords(d.cfg.WordLength, d.cfg.MaxGuesses, d.cfg.Lang().Words)
+		result = d.drill.ScoreGuess(guess, b.RankGuesses(d.drill.Candidates))
+	}
+
+	d.result = &result
+	d.progress.Add(d.drill.Type, result.Score)
+	if err := d.progress.Save(d.cfg.DrillsFile()); err != nil {
+		d.message = fmt.Sprintf("could not save the drill progress: %v", err)
+	}
+	return d
+}
+
+func (d drillModel) View(st styles) string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render("Training drills (←/→: drill type, ctrl+n: another drill, esc to go back)"))
+	b.WriteString("\n")
+
+	tabs := make([]string, len(drill.Types))
+	for i, kind := range drill.Types {
+		if i == d.kind {
+			tabs[i] = activeTabStyle.Render(drillTitles[kind])
+		} else {
+			tabs[i] = tabStyle.Render(drillTitles[kind])
+		}
+	}
+	b.WriteString(strings.Join(tabs, "   ") + "\n\n")
+
+	for _, row := range d.drill.Rows {
+		tiles := make([]string, len(row))
+		for i, c := range row {
+			tiles[i] = st.renderCell(c)
+		}
+		b.WriteString(joinTiles(tiles) + "\n")
+	}
+	b.WriteString("\n" + drillInstructions[d.drill.Type] + "\n")
+	b.WriteString("> " + string(d.input))
+	if d.result == nil {
+		b.WriteString("_")
+	}
+	b.WriteString("\n")
+
+	if d.result != nil {
+		b.WriteString("\n" + d.viewResult(*d.result) + "\n")
+		b.WriteString(dimStyle.Render("Enter for the next drill") + "\n")
+	}
+	if d.message != "" {
+		b.WriteString("\n" + d.message + "\n")
+	}
+
+	b.WriteString("\n" + d.viewProgress())
+	return b.String()
+}
+
+func (d drillModel) viewResult(r drill.Result) string {
+	lines := []string{sectionStyle.Render(fmt.Sprintf("Score: %d/100", r.Score))}
+
+	switch d.drill.Type {
+	case drill.TypeCandidates:
+		lines = append(lines, fmt.Sprintf("%d answers were left: %s", len(d.drill.Candidates), strings.Join(d.drill.Candidates, " ")))
+		if len(r.Missed) > 0 {
+			lines = append(lines, "missed: "+strings.Join(r.Missed, " "))
+		}
+		if len(r.Wrong) > 0 {
+			lines = append(lines, "don't fit: "+strings.Join(r.Wrong, " "))
+		}
+	case drill.TypeBestGuess:
+		lines = append(lines,
+			fmt.Sprintf("your guess: %.2f bits, #%d of %d", r.Entropy, r.Rank, r.Ranked),
+			fmt.Sprintf("bot's pick: %s, %.2f bits", r.Best, r.BestEntropy),
+			fmt.Sprintf("%d answers were left: %s", len(d.drill.Candidates), strings.Join(d.drill.Candidates, " ")),
+		)
+	}
+	lines = append(lines, fmt.Sprintf("the answer was %s", d.drill.Answer))
+
+	return lipgloss.NewStyle().Width(70).Render(strings.Join(lines, "\n"))
+}
+
+// viewProgress sums up every drill type with a trend of its last scores.
+func (d drillModel) viewProgress() string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render("Progress"))
+	b.WriteString("\n")
+
+	for _, kind := range drill.Types {
+		r := d.progress[kind]
+		if r.Played == 0 {
+			b.WriteString(fmt.Sprintf("%-20s %s\n", drillTitles[kind], dimStyle.Render("not tried yet")))
+			continue
+		}
+
+		scores := make([]float64, len(r.Recent))
+		present := make([]bool, len(r.Recent))
+		for i, score := range r.Recent {
+			scores[i], present[i] = float64(score), true
+		}
+		b.WriteString(fmt.Sprintf("%-20s %3d played, average %3.0f, best %3d  %s\n",
+			drillTitles[kind], r.Played, r.Average(), r.Best, sparkline(scores, present, 0, 100)))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (m model) updateDrills(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		return m.updateWindowSize(msg)
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+
+		drills, open := m.drills.Update(msg)
+		m.drills = drills
+		if !open {
+			m.screen = screenGame
+			return m, tea.ClearScreen
+		}
+	}
+
+	return m, nil
+}
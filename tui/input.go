@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// how many edits of the current row ctrl+z can take back
+const maxUndo = 50
+
+// inputSnapshot is the row being typed as it was before an edit.
+type inputSnapshot struct {
+	letters []rune
+	cursor  int
+}
+
+// updateInput edits the row being typed. letters overtype the one under the
+// cursor, or are added when the cursor is at the end, and a paste replaces
+// the row with the first word pasted. it reports whether the key was an
+// editing key.
+func (m model) updateInput(msg tea.KeyMsg) (model, bool) {
+	before := inputSnapshot{letters: append([]rune{}, m.current...), cursor: m.cursor}
+
+	switch msg.String() {
+	case "left":
+		m.cursor = max(m.cursor-1, 0)
+		return m, true
+	case "right":
+		m.cursor = min(m.cursor+1, len(m.current))
+		return m, true
+	case "home":
+		m.cursor = 0
+		return m, true
+	case "end":
+		m.cursor = len(m.current)
+		return m, true
+	case "ctrl+z":
+		if len(m.undo) > 0 {
+			last := m.undo[len(m.undo)-1]
+			m.undo = m.undo[:len(m.undo)-1]
+			m.current, m.cursor = last.letters, last.cursor
+			m = m.refreshCandidates()
+		}
+		m.message = ""
+		return m, true
+	case "ctrl+w":
+		m.current, m.cursor = []rune{}, 0
+	case "backspace":
+		if m.cursor > 0 {
+			m.current = append(m.current[:m.cursor-1:m.cursor-1], m.current[m.cursor:]...)
+			m.cursor--
+		}
+	case "delete":
+		if m.cursor < len(m.current) {
+			m.current = append(m.current[:m.cursor:m.cursor], m.current[m.cursor+1:]...)
+		}
+	default:
+		if msg.Type != tea.KeyRunes {
+			return m, false
+		}
+		if msg.Paste {
+			m.current = m.pastedWord(string(msg.Runes))
+			m.cursor = len(m.current)
+			break
+		}
+		language := m.cfg.Lang()
+		for _, r := range language.Normalize(string(msg.Runes)) {
+			if !language.InAlphabet(r) {
+				continue
+			}
+			switch {
+			case m.cursor < len(m.current):
+				m.current[m.cursor] = r
+				m.cursor++
+			case len(m.current) < m.gameState.GetWordLength():
+				m.current = append(m.current, r)
+				m.cursor++
+			}
+		}
+	}
+
+	m.message = ""
+	if !slices.Equal(before.letters, m.current) {
+		m.undo = append(m.undo, before)
+		if len(m.undo) > maxUndo {
+			m.undo = m.undo[1:]
+		}
+		m = m.refreshCandidates()
+	}
+
+	return m, true
+}
+
+// pastedWord is the letters of the first word in text, cut to the word
+// length.
+func (m model) pastedWord(text string) []rune {
+	language := m.cfg.Lang()
+	fields := strings.Fields(language.Normalize(text))
+	if len(fields) == 0 {
+		return m.current
+	}
+
+	word := []rune{}
+	for _, r := range fields[0] {
+		if language.InAlphabet(r) && len(word) < m.gameState.GetWordLength() {
+			word = append(word, r)
+		}
+	}
+	if len(word) == 0 {
+		return m.current
+	}
+
+	return word
+}
+
+// clearInput empties the row being typed, e.g. after it was submitted.
+func (m model) clearInput() model {
+	m.current = []rune{}
+	m.cursor = 0
+	m.undo = nil
+	return m
+}
@@ -0,0 +1,121 @@
+package tui
+
+import (
+	"strings"
+
+	"koutaroyumiba/wordle/game"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	enterKey     = "enter"
+	backspaceKey = "⌫"
+)
+
+// keyZone is the area a rendered on-screen key takes up, so a mouse click
+// can be mapped back to the key.
+type keyZone struct {
+	key    string
+	x0, x1 int // columns, x1 exclusive
+	y      int
+}
+
+// keyboardZones is shared between copies of the model so View can record
+// where it drew the keyboard for Update to hit test against.
+type keyboardZones struct {
+	zones []keyZone
+}
+
+// place stores zones relative to the keyboard moved to its position on
+// screen.
+func (k *keyboardZones) place(zones []keyZone, x, y int) {
+	k.zones = k.zones[:0]
+	for _, z := range zones {
+		z.x0 += x
+		z.x1 += x
+		z.y += y
+		k.zones = append(k.zones, z)
+	}
+}
+
+func (k *keyboardZones) keyAt(x, y int) (string, bool) {
+	for _, z := range k.zones {
+		if z.y == y && x >= z.x0 && x < z.x1 {
+			return z.key, true
+		}
+	}
+
+	return "", false
+}
+
+// renderKeyboard draws the layout rows with enter and backspace around
+// the last row, returning the zones of every key relative to the top left.
+// keys whose letter count is known get a hint such as "e≥2".
+func (st styles) renderKeyboard(rows []string, known map[rune]game.CellState, knowledge game.Knowledge) (string, []keyZone) {
+	outRows := make([]string, len(rows))
+	zones := []keyZone{}
+	for ri, row := range rows {
+		keys := strings.Split(row, "")
+		if ri == len(rows)-1 {
+			keys = append([]string{enterKey}, keys...)
+			keys = append(keys, backspaceKey)
+		}
+
+		parts := []string{}
+		x := 0
+		for _, key := range keys {
+			var part string
+			if key == enterKey || key == backspaceKey {
+				part = st.empty.Render(key)
+			} else {
+				part = st.renderKey([]rune(key)[0], known, knowledge)
+			}
+
+			width := lipgloss.Width(part)
+			zones = append(zones, keyZone{key: key, x0: x, x1: x + width, y: ri})
+			x += width + 1
+			parts = append(parts, part)
+		}
+		outRows[ri] = strings.Join(parts, " ")
+	}
+	return strings.Join(outRows, "\n"), zones
+}
+
+func (st styles) renderKey(ch rune, known map[rune]game.CellState, knowledge game.Knowledge) string {
+	s, ok := known[ch]
+	cellRep := string(ch) + knowledge.CountHint(ch)
+	switch {
+	case ok && s == game.StateCorrect:
+		return st.correct.Render(cellRep)
+	case ok && s == game.StatePresent:
+		return st.present.Render(cellRep)
+	case ok && s == game.StateAbsent:
+		return st.absent.Render(cellRep)
+	default:
+		return st.empty.Render(cellRep)
+	}
+}
+
+// updateMouse turns a left click on an on-screen key into the matching
+// key press.
+func (m model) updateMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if m.done || msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return m, nil
+	}
+
+	key, ok := m.keyboard.keyAt(msg.X, msg.Y)
+	if !ok {
+		return m, nil
+	}
+
+	switch key {
+	case enterKey:
+		return m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	case backspaceKey:
+		return m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	default:
+		return m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	}
+}
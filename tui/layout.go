@@ -0,0 +1,308 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"koutaroyumiba/wordle/dict"
+	"koutaroyumiba/wordle/game"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type arrangement int
+
+const (
+	// board, assistant and keyboard on the left, statistics on the right
+	arrangeWide arrangement = iota
+	// everything in one column, the original layout
+	arrangeStacked
+	// one page at a time, switched with the number keys
+	arrangeCompact
+)
+
+type page int
+
+const (
+	pageGame page = iota
+	pageAssistant
+	pageStats
+	pageCount
+)
+
+var pageNames = map[page]string{
+	pageGame:      "Game",
+	pageAssistant: "Assistant",
+	pageStats:     "Stats",
+}
+
+var (
+	tabStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
+	activeTabStyle = lipgloss.NewStyle().Bold(true).Underline(true).Foreground(lipgloss.Color("#ffffff"))
+)
+
+// panels are the separately rendered parts of the game screen, ready to be
+// arranged for the terminal size.
+type panels struct {
+	board     string
+	assistant string
+	hints     string
+	keyboard  string
+	zones     []keyZone
+	status    string
+	stats     string
+}
+
+func (m model) updateWindowSize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
+	m.width = msg.Width
+	m.height = msg.Height
+	return m, tea.ClearScreen
+}
+
+// updatePage switches pages of the compact layout with the number keys.
+func (m model) updatePage(msg tea.KeyMsg) (model, bool) {
+	if msg.Type != tea.KeyRunes || len(msg.Runes) != 1 || msg.Paste {
+		return m, false
+	}
+
+	n := int(msg.Runes[0] - '1')
+	if n < 0 || n >= int(pageCount) {
+		return m, false
+	}
+
+	m.page = page(n)
+	return m, true
+}
+
+func (m model) View() string {
+	if m.screen == screenSettings {
+		return m.settings.View()
+	}
+	if m.screen == screenStats {
+		return m.viewDashboard()
+	}
+	if m.screen == screenSolver {
+		return m.solver.View(m.styles)
+	}
+	if m.screen == screenLeaderboard {
+		return m.viewLeaderboard()
+	}
+	if m.screen == screenReverse {
+		return m.reverse.View(m.styles)
+	}
+	if m.screen == screenDrills {
+		return m.drills.View(m.styles)
+	}
+
+	length, words := m.wordsLeft, m.leftWords
+
+	// until the terminal size is known keep to the original single column
+	if m.width == 0 || m.height == 0 {
+		return m.arrange(m.renderPanels(m.styles, length, words), arrangeStacked)
+	}
+
+	// use the roomiest arrangement and the biggest tiles that fit
+	small := m.renderPanels(m.styles, length, words)
+	large := m.renderPanels(m.styles.large(), length, words)
+	for _, a := range []arrangement{arrangeWide, arrangeStacked, arrangeCompact} {
+		for _, p := range []panels{large, small} {
+			view := m.arrange(p, a)
+			if lipgloss.Width(view) <= m.width && lipgloss.Height(view) <= m.height {
+				return view
+			}
+		}
+	}
+
+	return m.arrange(small, arrangeCompact)
+}
+
+func (m model) renderPanels(st styles, length []int, words [][]string) panels {
+	var p panels
+
+	// board and the bot's view of each row, line for line
+	rows := m.gameState.GetMaxGuesses()
+	boardRows := make([]string, rows)
+	assistantRows := make([]string, rows)
+	for i := range rows {
+		boardRows[i] = m.renderGuessRow(st, i)
+
+		// centre the text on the tiles
+		line := m.assistantRow(i, length, words)
+		assistantRows[i] = strings.Repeat("\n", st.tileHeight/2) + line + strings.Repeat("\n", st.tileHeight-st.tileHeight/2)
+	}
+	p.board = lipgloss.JoinVertical(lipgloss.Left, boardRows...)
+	p.assistant = lipgloss.JoinVertical(lipgloss.Left, assistantRows...)
+	p.hints = m.viewHints()
+
+	p.keyboard, p.zones = m.styles.renderKeyboard(m.cfg.LayoutRows(), m.gameState.GetKnown(), m.gameState.GetKnowledge())
+	p.status = m.viewStatus()
+	p.stats = m.viewStats()
+
+	return p
+}
+
+// arrange lays the panels out and records where the keyboard ended up.
+func (m model) arrange(p panels, a arrangement) string {
+	title := fmt.Sprintf("%s (tab: settings, ctrl+t: stats, ctrl+o: solver, ctrl+r: reverse, ctrl+d: drills, ctrl+l: leaderboard, ctrl+a: assistant %s, ctrl+c: exit)", m.gameTitle(), m.assist)
+	board := lipgloss.JoinHorizontal(lipgloss.Top, p.board, "  ", p.assistant)
+	hints := ""
+	if p.hints != "" {
+		// wrap to the board so a long list doesn't push the layout wider
+		hints = lipgloss.NewStyle().Width(max(lipgloss.Width(board), 40)).Render(p.hints)
+		board = lipgloss.JoinVertical(lipgloss.Left, board, hints, "")
+	}
+
+	// the status line is only there when there is something to say
+	status := []string{}
+	if p.status != "" {
+		status = []string{"", p.status}
+	}
+
+	switch a {
+	case arrangeWide:
+		header := headerStyle.Render(title)
+		left := lipgloss.JoinVertical(lipgloss.Left, append([]string{board, "Keyboard:", p.keyboard}, status...)...)
+		body := lipgloss.JoinHorizontal(lipgloss.Top, left, "    ", p.stats)
+		m.keyboard.place(p.zones, 0, lipgloss.Height(header)+lipgloss.Height(board)+1)
+		return lipgloss.JoinVertical(lipgloss.Left, header, body)
+
+	case arrangeCompact:
+		tabs := make([]string, pageCount)
+		for pg := range pageCount {
+			label := fmt.Sprintf("%d %s", pg+1, pageNames[pg])
+			if pg == m.page {
+				tabs[pg] = activeTabStyle.Render(label)
+			} else {
+				tabs[pg] = tabStyle.Render(label)
+			}
+		}
+		header := lipgloss.JoinVertical(lipgloss.Left, headerStyle.UnsetMarginBottom().Render(m.gameTitle()), strings.Join(tabs, "  "), "")
+
+		switch m.page {
+		case pageAssistant:
+			m.keyboard.place(nil, 0, 0)
+			if m.assist == assistOff && !m.done {
+				return lipgloss.JoinVertical(lipgloss.Left, header, "The assistant is off, press ctrl+a to turn it on.")
+			}
+			return lipgloss.JoinVertical(lipgloss.Left, header, p.assistant, hints)
+		case pageStats:
+			m.keyboard.place(nil, 0, 0)
+			return lipgloss.JoinVertical(lipgloss.Left, header, p.stats)
+		}
+		m.keyboard.place(p.zones, 0, lipgloss.Height(header)+lipgloss.Height(p.board))
+		return lipgloss.JoinVertical(lipgloss.Left, append([]string{header, p.board, p.keyboard}, status...)...)
+	}
+
+	header := headerStyle.Render(title)
+	m.keyboard.place(p.zones, 0, lipgloss.Height(header)+lipgloss.Height(board)+1)
+	parts := append([]string{header, board, "Keyboard:", p.keyboard}, status...)
+	return lipgloss.JoinVertical(lipgloss.Left, append(parts, "", p.stats)...)
+}
+
+// gameTitle names the rules when they aren't the standard ones.
+func (m model) gameTitle() string {
+	if ev := m.gameState.GetEvaluator(); ev != game.Standard {
+		return "Terminal Wordle, " + ev.Name() + " rules"
+	}
+	return "Terminal Wordle"
+}
+
+// renderGuessRow is board row i. under rules where the feedback isn't tied
+// to the letters the letters are left uncoloured and the number of correct
+// and present letters is shown next to them.
+func (m model) renderGuessRow(st styles, i int) string {
+	cells := m.gameState.GetCurrentBoardRow(m.current, i)
+	if m.gameState.GetEvaluator().Positional() || i >= m.gameState.GetAttempts() {
+		return m.renderBoardRow(st, cells, i)
+	}
+
+	correct, present := game.Feedback(cells)
+	letters := make([]game.Cell, len(cells))
+	for j, c := range cells {
+		char, _ := c.GetInfo()
+		letters[j] = game.NewCell(char, game.StateEmpty)
+	}
+	pegs := joinTiles([]string{
+		st.renderTile(rune('0'+correct), game.StateCorrect),
+		st.renderTile(rune('0'+present), game.StatePresent),
+	})
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, m.renderBoardRow(st, letters, i), "   ", pegs)
+}
+
+func (m model) viewStatus() string {
+	var b strings.Builder
+
+	// message
+	if m.message != "" {
+		b.WriteString("msg: ")
+		b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff")).Render(m.message))
+		b.WriteString("\n")
+	}
+
+	winningStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#6aaa64"))
+	losingStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#ff5f87"))
+
+	if m.done {
+		if m.win {
+			b.WriteString(winningStyle.Render("\ncongrats\n"))
+		} else {
+			b.WriteString(losingStyle.Render(fmt.Sprintf("\ngg u suck, word: %s\n", m.gameState.GetAnswer())))
+		}
+		if score, ok := game.AnswerRating(m.gameState.GetAnswer()); ok {
+			b.WriteString(fmt.Sprintf("\ndifficulty: %d/100 (%s)", score, game.TierOf(score)))
+		}
+		if entry, ok := dict.Lookup(m.gameState.GetAnswer()); ok {
+			width := 60
+			if m.width > 0 {
+				width = min(width, m.width)
+			}
+			b.WriteString("\n")
+			b.WriteString(definitionStyle.Width(width).Render(entry.Word + ": " + entry.String()))
+		}
+		if m.gameState.IsAssisted() {
+			b.WriteString("\n(played with the assistant)")
+		}
+		if daily := m.gameState.GetDaily(); daily > 0 {
+			b.WriteString(fmt.Sprintf("\ndaily puzzle #%d", daily))
+		}
+		b.WriteString("\nPress r to play again, s for statistics, l for the leaderboard, q to quit.\n")
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (m model) viewStats() string {
+	var b strings.Builder
+	stats := m.gameState.GetStats()
+
+	b.WriteString("--- Statistics ---\n")
+	b.WriteString(fmt.Sprintf("Games Played: %d\n", stats.GamesPlayed))
+	b.WriteString(fmt.Sprintf("Wins: %d\n", stats.Wins))
+	b.WriteString(fmt.Sprintf("Win Rate: %.1f%%\n", stats.WinRate()))
+	b.WriteString(fmt.Sprintf("Current Streak: %d\n", stats.CurrentStreak))
+	b.WriteString(fmt.Sprintf("Max Streak: %d\n", stats.MaxStreak))
+	b.WriteString(fmt.Sprintf("Avg Guesses (wins): %.2f\n", stats.AverageGuesses()))
+	b.WriteString(fmt.Sprintf("Assisted Games: %d\n", stats.AssistedGames()))
+
+	distribution := stats.GuessFrequency
+	total := 0
+	for _, c := range distribution {
+		total += c
+	}
+
+	for i := range m.cfg.MaxGuesses {
+		count, ok := distribution[i+1]
+		if !ok {
+			count = 0
+		}
+		bar := 0
+		if total > 0 {
+			bar = int(float64(count) / float64(total) * 30)
+		}
+		b.WriteString(fmt.Sprintf("%d : %s[%d]\n", i+1, strings.Repeat("#", bar), count))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,175 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/leaderboard"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// rankings of the leaderboard screen, switched with ←/→
+var rankings = []struct {
+	name  string
+	title string
+}{
+	{"guesses", "Fewest guesses"},
+	{"time", "Fastest"},
+	{"streak", "Streaks"},
+}
+
+// leaderboardScreen is the last board fetched from the server.
+type leaderboardScreen struct {
+	board   leaderboard.Board
+	err     error
+	loading bool
+	ranking int
+}
+
+type boardMsg struct {
+	board leaderboard.Board
+	err   error
+}
+
+type submittedMsg struct {
+	err error
+}
+
+// openLeaderboard switches to the leaderboard and fetches today's board.
+func (m model) openLeaderboard() (tea.Model, tea.Cmd) {
+	m.screen = screenLeaderboard
+	m.leaderboard.loading = m.cfg.Leaderboard != ""
+	if !m.leaderboard.loading {
+		return m, tea.ClearScreen
+	}
+
+	client := leaderboard.NewClient(m.cfg.Leaderboard, m.cfg.LeaderboardKey)
+	fetch := func() tea.Msg {
+		board, err := client.Board(game.DailyNumber(time.Now()), m.cfg.Language)
+		return boardMsg{board: board, err: err}
+	}
+
+	return m, tea.Batch(tea.ClearScreen, fetch)
+}
+
+// submitGame sends a finished daily game to the leaderboard in the
+// background.
+func (m model) submitGame() tea.Cmd {
+	cfg, g := m.cfg, m.gameState
+	return func() tea.Msg {
+		if submitted, err := leaderboard.SubmitGame(cfg, g); err != nil || submitted {
+			return submittedMsg{err: err}
+		}
+		return nil
+	}
+}
+
+func (m model) updateLeaderboard(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case boardMsg:
+		m.leaderboard.board, m.leaderboard.err, m.leaderboard.loading = msg.board, msg.err, false
+	case tea.WindowSizeMsg:
+		return m.updateWindowSize(msg)
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "esc", "q", "ctrl+l":
+			m.screen = screenGame
+			return m, tea.ClearScreen
+		case "r":
+			return m.openLeaderboard()
+		case "left", "h":
+			m.leaderboard.ranking = (m.leaderboard.ranking + len(rankings) - 1) % len(rankings)
+		case "right", "l":
+			m.leaderboard.ranking = (m.leaderboard.ranking + 1) % len(rankings)
+		}
+	}
+
+	return m, nil
+}
+
+func (m model) viewLeaderboard() string {
+	header := headerStyle.Render("Leaderboard (←/→: ranking, r: refresh, esc to go back)")
+	s := m.leaderboard
+
+	switch {
+	case m.cfg.Leaderboard == "":
+		return lipgloss.JoinVertical(lipgloss.Left, header,
+			"No leaderboard server is configured.",
+			dimStyle.Render("Set leaderboard and leaderboard_key in the config, then play with -daily."))
+	case s.loading:
+		return lipgloss.JoinVertical(lipgloss.Left, header, "Loading "+m.cfg.Leaderboard+"...")
+	case s.err != nil:
+		return lipgloss.JoinVertical(lipgloss.Left, header, fmt.Sprintf("Could not load the leaderboard: %v", s.err))
+	}
+
+	players := append([]leaderboard.PlayerEntry(nil), s.board.Players...)
+	leaderboard.Rankings[rankings[s.ranking].name](players)
+
+	tabs := make([]string, len(rankings))
+	for i, r := range rankings {
+		if i == s.ranking {
+			tabs[i] = sectionStyle.Render(r.title)
+		} else {
+			tabs[i] = dimStyle.Render(r.title)
+		}
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, header,
+		m.viewDaily(s.board), "",
+		strings.Join(tabs, "   "),
+		viewPlayers(players, rankings[s.ranking].name),
+	)
+}
+
+func (m model) viewDaily(board leaderboard.Board) string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render(fmt.Sprintf("Daily puzzle #%d", board.Day)))
+	b.WriteString("\n")
+
+	if len(board.Daily) == 0 {
+		b.WriteString(dimStyle.Render("no results yet, be the first with -daily"))
+		return b.String()
+	}
+	me := m.cfg.PlayerName()
+	for _, e := range board.Daily {
+		line := fmt.Sprintf("%3d. %-16s %s/%d  %s", e.Rank, e.Player, leaderboard.Score(e.Guesses, e.Won), game.DailyMaxGuesses, leaderboard.Duration(e.Seconds))
+		if e.Player == me {
+			line = m.styles.correct.Padding(0).Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// viewPlayers lists the players with the column they are ranked by first.
+func viewPlayers(players []leaderboard.PlayerEntry, ranking string) string {
+	if len(players) == 0 {
+		return dimStyle.Render("no players yet")
+	}
+
+	var b strings.Builder
+	for i, p := range players {
+		var value string
+		switch ranking {
+		case "guesses":
+			value = fmt.Sprintf("%.2f avg guesses", p.AverageGuesses)
+		case "time":
+			value = leaderboard.Duration(int(p.AverageSeconds)) + " avg, best " + leaderboard.Duration(p.BestSeconds)
+		case "streak":
+			value = fmt.Sprintf("%d current, %d longest", p.CurrentStreak, p.MaxStreak)
+		}
+		if p.Wins == 0 && ranking != "streak" {
+			value = "no wins yet"
+		}
+		b.WriteString(fmt.Sprintf("%3d. %-16s %-28s %d/%d won\n", i+1, p.Player, value, p.Wins, p.Played))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
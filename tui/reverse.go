@@ -0,0 +1,204 @@
+package tui
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"koutaroyumiba/wordle/bot"
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/game"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// other solutions listed per row once the puzzle is done
+const listedSolutions = 5
+
+// reverseModel plays reverse wordle: the answer and a grid of colours are
+// shown and the player finds a word for each row that gives its colours.
+type reverseModel struct {
+	cfg    config.Config
+	puzzle game.ReversePuzzle
+	// what the bot found for each row, worked out once per puzzle
+	solutions [][]string
+	found     []string
+	skipped   int
+	word      []rune
+	message   string
+}
+
+func newReverse(cfg config.Config) reverseModel {
+	answers := cfg.Lang().Answers
+	answer := answers[rand.Intn(len(answers))]
+	puzzle := game.NewReversePuzzle(answer, cfg.Lang().Words, cfg.MaxGuesses-1)
+
+	b := bot.InitBotWithWords(cfg.WordLength, cfg.MaxGuesses, cfg.Lang().Words)
+	solutions := make([][]string, len(puzzle.Patterns))
+	for i, pattern := range puzzle.Patterns {
+		solutions[i] = b.PatternSolutions(answer, pattern)
+	}
+
+	return reverseModel{cfg: cfg, puzzle: puzzle, solutions: solutions}
+}
+
+func (r reverseModel) solved() bool {
+	return len(r.found) == len(r.puzzle.Patterns)
+}
+
+// Update returns false once the player leaves the puzzle.
+func (r reverseModel) Update(msg tea.KeyMsg) (reverseModel, bool) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		return r, false
+	case tea.KeyCtrlN:
+		return newReverse(r.cfg), true
+	case tea.KeyRunes:
+		if r.solved() {
+			return r, true
+		}
+		language := r.cfg.Lang()
+		for _, char := range language.Normalize(string(msg.Runes)) {
+			if len(r.word) < r.cfg.WordLength && language.InAlphabet(char) {
+				r.word = append(r.word, char)
+			}
+		}
+		r.message = ""
+	case tea.KeyBackspace:
+		if len(r.word) > 0 {
+			r.word = r.word[:len(r.word)-1]
+		}
+		r.message = ""
+	case tea.KeyEnter:
+		return r.submit(), true
+	case tea.KeyTab:
+		// give up on the row and fill in one of its solutions
+		if !r.solved() {
+			r.found = append(r.found, r.solutions[len(r.found)][0])
+			r.skipped++
+			r.word = nil
+			r.message = ""
+			r = r.finish()
+		}
+	}
+
+	return r, true
+}
+
+func (r reverseModel) submit() reverseModel {
+	if r.solved() {
+		return r
+	}
+	if len(r.word) != r.cfg.WordLength {
+		r.message = fmt.Sprintf("Guess must be %d letters.", r.cfg.WordLength)
+		return r
+	}
+
+	guess := string(r.word)
+	if ok, reason := r.puzzle.Check(len(r.found), guess); !ok {
+		r.message = reason
+		return r
+	}
+	r.found = append(r.found, guess)
+	r.word = nil
+
+	return r.finish()
+}
+
+func (r reverseModel) finish() reverseModel {
+	switch {
+	case !r.solved():
+	case r.skipped > 0:
+		r.message = fmt.Sprintf("Done, %d of %d rows found. ctrl+n for another puzzle.", len(r.found)-r.skipped, len(r.found))
+	default:
+		r.message = "Solved! ctrl+n for another puzzle."
+	}
+	return r
+}
+
+func (r reverseModel) View(st styles) string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render("Reverse Wordle (tab: skip row, ctrl+n: new puzzle, esc to go back)"))
+	b.WriteString("\n")
+	b.WriteString("Find a word for each row that gives its colours against the answer.\n\n")
+
+	answer := []rune(r.puzzle.Answer)
+	tiles := make([]string, len(answer))
+	for i, char := range answer {
+		tiles[i] = st.renderTile(char, game.StateCorrect)
+	}
+	b.WriteString("answer: " + joinTiles(tiles) + "\n\n")
+
+	indent := strings.Repeat(" ", len("answer: "))
+	for i, pattern := range r.puzzle.Patterns {
+		var letters []rune
+		switch {
+		case i < len(r.found):
+			letters = []rune(r.found[i])
+		case i == len(r.found):
+			letters = r.word
+		}
+
+		tiles := make([]string, len(pattern))
+		for j, state := range pattern {
+			char := ' '
+			if j < len(letters) {
+				char = letters[j]
+			}
+			tiles[j] = st.renderTile(char, state)
+		}
+		b.WriteString(indent + joinTiles(tiles))
+		b.WriteString("   " + r.rowNote(i))
+		b.WriteString("\n")
+	}
+
+	if r.message != "" {
+		b.WriteString("\n" + r.message + "\n")
+	}
+
+	return b.String()
+}
+
+// rowNote is how many words solve row i, and once the puzzle is done a few
+// of the other ones.
+func (r reverseModel) rowNote(i int) string {
+	solutions := r.solutions[i]
+	note := fmt.Sprintf("%d solutions", len(solutions))
+	if len(solutions) == 1 {
+		note = "1 solution"
+	}
+	if !r.solved() {
+		return note
+	}
+
+	others := []string{}
+	for _, word := range solutions {
+		if word != r.found[i] && len(others) < listedSolutions {
+			others = append(others, word)
+		}
+	}
+	if len(others) > 0 {
+		note += ", also: " + strings.Join(others, " ")
+	}
+	return note
+}
+
+func (m model) updateReverse(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		return m.updateWindowSize(msg)
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+
+		reverse, open := m.reverse.Update(msg)
+		m.reverse = reverse
+		if !open {
+			m.screen = screenGame
+			return m, tea.ClearScreen
+		}
+	}
+
+	return m, nil
+}
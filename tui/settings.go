@@ -0,0 +1,297 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/game"
+	"koutaroyumiba/wordle/lang"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type settingsField int
+
+const (
+	fieldLanguage settingsField = iota
+	fieldWordLength
+	fieldMaxGuesses
+	fieldTheme
+	fieldKeyboardLayout
+	fieldStatsPath
+	fieldDictionary
+	fieldHardMode
+	fieldAnimations
+	fieldAssistant
+	fieldPick
+	fieldDifficulty
+	fieldTier
+	fieldRules
+	fieldCount
+)
+
+var fieldNames = map[settingsField]string{
+	fieldLanguage:       "Language",
+	fieldWordLength:     "Word length",
+	fieldMaxGuesses:     "Max guesses",
+	fieldTheme:          "Theme",
+	fieldKeyboardLayout: "Keyboard layout",
+	fieldStatsPath:      "Stats file",
+	fieldDictionary:     "Enforce dictionary",
+	fieldHardMode:       "Hard mode",
+	fieldAnimations:     "Animations",
+	fieldAssistant:      "Assistant",
+	fieldPick:           "Answer pick",
+	fieldDifficulty:     "Difficulty from",
+	fieldTier:           "Answer tier",
+	fieldRules:          "Rules",
+}
+
+type settingsAction int
+
+const (
+	settingsNone settingsAction = iota
+	settingsSave
+	settingsCancel
+)
+
+type settingsModel struct {
+	cfg config.Config
+	// the settings when the screen was opened, to tell which were edited
+	opened  config.Config
+	cursor  settingsField
+	editing bool // typing a new stats path
+	input   []rune
+	message string
+}
+
+func newSettings(cfg config.Config) settingsModel {
+	return settingsModel{cfg: cfg, opened: cfg}
+}
+
+func (s settingsModel) Update(msg tea.KeyMsg) (settingsModel, settingsAction) {
+	if s.editing {
+		switch msg.Type {
+		case tea.KeyRunes:
+			s.input = append(s.input, msg.Runes...)
+		case tea.KeyBackspace:
+			if len(s.input) > 0 {
+				s.input = s.input[:len(s.input)-1]
+			}
+		case tea.KeyEnter:
+			s.cfg.StatsPath = string(s.input)
+			s.editing = false
+		case tea.KeyEsc:
+			s.editing = false
+		}
+		return s, settingsNone
+	}
+
+	s.message = ""
+	switch msg.String() {
+	case "up", "k":
+		s.cursor = (s.cursor + fieldCount - 1) % fieldCount
+	case "down", "j":
+		s.cursor = (s.cursor + 1) % fieldCount
+	case "left", "h":
+		s.change(-1)
+	case "right", "l", " ":
+		s.change(1)
+	case "enter":
+		if s.cursor == fieldStatsPath {
+			s.editing = true
+			s.input = []rune(s.cfg.StatsPath)
+		} else {
+			s.change(1)
+		}
+	case "s":
+		if err := s.cfg.Validate(); err != nil {
+			s.message = err.Error()
+			return s, settingsNone
+		}
+		return s, settingsSave
+	case "esc", "q":
+		return s, settingsCancel
+	}
+
+	return s, settingsNone
+}
+
+// change steps the selected setting forwards or backwards through its
+// allowed values.
+func (s *settingsModel) change(step int) {
+	switch s.cursor {
+	case fieldLanguage:
+		previous := s.cfg.Lang().Layout
+		s.cfg.Language = cycle(lang.Codes(), s.cfg.Language, step)
+		// keep a layout that was picked, unless it was only the old
+		// language's keyboard or doesn't have the new language's letters
+		if s.cfg.KeyboardLayout == previous || s.cfg.CheckLayout() != nil {
+			s.cfg.KeyboardLayout = s.cfg.Lang().Layout
+		}
+	case fieldWordLength:
+		s.cfg.WordLength = cycle(config.WordLengths, s.cfg.WordLength, step)
+	case fieldMaxGuesses:
+		s.cfg.MaxGuesses = min(max(s.cfg.MaxGuesses+step, config.MinGuesses), config.MaxGuessesLimit)
+	case fieldTheme:
+		s.cfg.Theme = cycle(config.Themes, s.cfg.Theme, step)
+	case fieldKeyboardLayout:
+		s.cfg.KeyboardLayout = cycle(s.cfg.LayoutNames(), s.cfg.KeyboardLayout, step)
+	case fieldDictionary:
+		s.cfg.EnforceDictionary = !s.cfg.EnforceDictionary
+	case fieldHardMode:
+		s.cfg.HardMode = !s.cfg.HardMode
+	case fieldAnimations:
+		s.cfg.Animations = !s.cfg.Animations
+	case fieldAssistant:
+		s.cfg.Assistant = cycle(config.AssistantLevels, s.cfg.Assistant, step)
+	case fieldPick:
+		s.cfg.Pick = cycle(config.Picks, s.cfg.Pick, step)
+	case fieldDifficulty:
+		s.cfg.Difficulty = cycle(config.DifficultySources, s.cfg.Difficulty, step)
+	case fieldTier:
+		s.cfg.Tier = cycle(config.Tiers, s.cfg.Tier, step)
+	case fieldRules:
+		s.cfg.Rules = cycle(game.RuleNames, s.cfg.Rules, step)
+		// hard mode needs feedback that can be trusted
+		if s.cfg.Rules != game.RulesStandard {
+			s.cfg.HardMode = false
+		}
+	}
+}
+
+func cycle[T comparable](values []T, current T, step int) T {
+	i := slices.Index(values, current)
+	if i < 0 {
+		return values[0]
+	}
+
+	return values[(i+step+len(values))%len(values)]
+}
+
+// edited puts the settings changed on this screen on top of file, the
+// config as saved, so flags given for one run aren't saved with them.
+func (s settingsModel) edited(file config.Config) config.Config {
+	for field := range fieldCount {
+		if fieldValue(s.opened, field) != fieldValue(s.cfg, field) {
+			setField(&file, s.cfg, field)
+		}
+	}
+
+	return file
+}
+
+func (s settingsModel) value(field settingsField) string {
+	if field == fieldStatsPath && s.editing {
+		return string(s.input) + "_"
+	}
+
+	return fieldValue(s.cfg, field)
+}
+
+func fieldValue(cfg config.Config, field settingsField) string {
+	switch field {
+	case fieldLanguage:
+		return cfg.Lang().Name
+	case fieldWordLength:
+		return fmt.Sprint(cfg.WordLength)
+	case fieldMaxGuesses:
+		return fmt.Sprint(cfg.MaxGuesses)
+	case fieldTheme:
+		return cfg.Theme
+	case fieldKeyboardLayout:
+		return cfg.KeyboardLayout
+	case fieldStatsPath:
+		return cfg.StatsPath
+	case fieldDictionary:
+		return onOff(cfg.EnforceDictionary)
+	case fieldHardMode:
+		return onOff(cfg.HardMode)
+	case fieldAnimations:
+		return onOff(cfg.Animations)
+	case fieldAssistant:
+		return cfg.Assistant
+	case fieldPick:
+		return cfg.Pick
+	case fieldDifficulty:
+		return cfg.Difficulty
+	case fieldTier:
+		return cfg.Tier
+	case fieldRules:
+		return cfg.Rules
+	}
+
+	return ""
+}
+
+// setField copies one setting from src to dst.
+func setField(dst *config.Config, src config.Config, field settingsField) {
+	switch field {
+	case fieldLanguage:
+		dst.Language = src.Language
+	case fieldWordLength:
+		dst.WordLength = src.WordLength
+	case fieldMaxGuesses:
+		dst.MaxGuesses = src.MaxGuesses
+	case fieldTheme:
+		dst.Theme = src.Theme
+	case fieldKeyboardLayout:
+		dst.KeyboardLayout = src.KeyboardLayout
+	case fieldStatsPath:
+		dst.StatsPath = src.StatsPath
+	case fieldDictionary:
+		dst.EnforceDictionary = src.EnforceDictionary
+	case fieldHardMode:
+		dst.HardMode = src.HardMode
+	case fieldAnimations:
+		dst.Animations = src.Animations
+	case fieldAssistant:
+		dst.Assistant = src.Assistant
+	case fieldPick:
+		dst.Pick = src.Pick
+	case fieldDifficulty:
+		dst.Difficulty = src.Difficulty
+	case fieldTier:
+		dst.Tier = src.Tier
+	case fieldRules:
+		dst.Rules = src.Rules
+	}
+}
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
+
+func (s settingsModel) View() string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render("Settings"))
+	b.WriteString("\n")
+
+	for field := range fieldCount {
+		cursor := "  "
+		if field == s.cursor {
+			cursor = "> "
+		}
+		b.WriteString(fmt.Sprintf("%s%-20s %s\n", cursor, fieldNames[field], s.value(field)))
+	}
+
+	b.WriteString("\n")
+	if s.editing {
+		b.WriteString("Type the stats file path, Enter to confirm, Esc to cancel.\n")
+	} else {
+		b.WriteString("↑/↓ select, ←/→ change, Enter to edit, s to save, Esc to go back.\n")
+	}
+
+	if s.message != "" {
+		b.WriteString("\n")
+		b.WriteString(s.message)
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
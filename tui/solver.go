@@ -0,0 +1,241 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"koutaroyumiba/wordle/bot"
+	"koutaroyumiba/wordle/config"
+	"koutaroyumiba/wordle/game"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// markOrder is the order space and up/down cycle a letter's colour in.
+var markOrder = []game.CellState{game.StateAbsent, game.StatePresent, game.StateCorrect}
+
+// solverModel helps with a game played somewhere else: the player types
+// each guess they made, marks the colours they got, and the bot narrows
+// down the words that are left.
+type solverModel struct {
+	cfg        config.Config
+	bot        bot.WordleBot
+	rows       [][]game.Cell
+	word       []rune
+	marks      []game.CellState
+	cursor     int
+	candidates []string
+	suggestion string
+	message    string
+}
+
+func newSolver(cfg config.Config) solverModel {
+	s := solverModel{
+		cfg: cfg,
+		bot: bot.InitBotWithWords(cfg.WordLength, cfg.MaxGuesses, cfg.Lang().Words),
+	}
+
+	return s.refresh()
+}
+
+// refresh filters the candidates with the rows entered so far, the same
+// way the in-game assistant does.
+func (s solverModel) refresh() solverModel {
+	s.candidates = s.bot.Candidates(s.rows, len(s.rows))
+	s.suggestion = s.bot.Suggest(s.candidates)
+
+	return s
+}
+
+// Update returns false once the player leaves the solver.
+func (s solverModel) Update(msg tea.KeyMsg) (solverModel, bool) {
+	s.message = ""
+
+	switch msg.Type {
+	case tea.KeyEsc:
+		return s, false
+	case tea.KeySpace:
+		s.mark(1)
+		s.cursor = min(s.cursor+1, max(len(s.word)-1, 0))
+	case tea.KeyRunes:
+		switch string(msg.Runes) {
+		case "1", "2", "3":
+			s.setMark(markOrder[msg.Runes[0]-'1'])
+			s.cursor = min(s.cursor+1, max(len(s.word)-1, 0))
+			return s, true
+		}
+
+		language := s.cfg.Lang()
+		for _, r := range language.Normalize(string(msg.Runes)) {
+			if len(s.word) < s.cfg.WordLength && language.InAlphabet(r) {
+				s.word = append(s.word, r)
+				s.marks = append(s.marks, game.StateAbsent)
+			}
+		}
+		// start marking from the first letter once the word is complete
+		if len(s.word) == s.cfg.WordLength {
+			s.cursor = 0
+		} else {
+			s.cursor = max(len(s.word)-1, 0)
+		}
+	case tea.KeyLeft:
+		s.cursor = max(s.cursor-1, 0)
+	case tea.KeyRight:
+		s.cursor = min(s.cursor+1, max(len(s.word)-1, 0))
+	case tea.KeyUp:
+		s.mark(1)
+	case tea.KeyDown:
+		s.mark(-1)
+	case tea.KeyBackspace:
+		if len(s.word) > 0 {
+			s.word = s.word[:len(s.word)-1]
+			s.marks = s.marks[:len(s.marks)-1]
+			s.cursor = min(s.cursor, max(len(s.word)-1, 0))
+			return s, true
+		}
+		// nothing typed, so take back the last row instead
+		if len(s.rows) > 0 {
+			s.rows = s.rows[:len(s.rows)-1]
+			s = s.refresh()
+		}
+	case tea.KeyTab:
+		if len(s.word) == 0 && s.suggestion != "" {
+			s.word = []rune(s.suggestion)
+			s.marks = make([]game.CellState, len(s.word))
+			for i := range s.marks {
+				s.marks[i] = game.StateAbsent
+			}
+			s.cursor = 0
+		}
+	case tea.KeyCtrlN:
+		return newSolver(s.cfg), true
+	case tea.KeyEnter:
+		return s.submit(), true
+	}
+
+	return s, true
+}
+
+func (s *solverModel) mark(step int) {
+	if s.cursor >= len(s.marks) {
+		return
+	}
+
+	current := 0
+	for i, state := range markOrder {
+		if state == s.marks[s.cursor] {
+			current = i
+		}
+	}
+	s.marks[s.cursor] = cycle(markOrder, markOrder[current], step)
+}
+
+func (s *solverModel) setMark(state game.CellState) {
+	if s.cursor < len(s.marks) {
+		s.marks[s.cursor] = state
+	}
+}
+
+func (s solverModel) submit() solverModel {
+	if len(s.word) != s.cfg.WordLength {
+		s.message = fmt.Sprintf("Type the %d letter word you guessed first.", s.cfg.WordLength)
+		return s
+	}
+
+	row := make([]game.Cell, len(s.word))
+	solved := true
+	for i, char := range s.word {
+		row[i] = game.NewCell(char, s.marks[i])
+		solved = solved && s.marks[i] == game.StateCorrect
+	}
+	s.rows = append(s.rows, row)
+	s.word, s.marks, s.cursor = nil, nil, 0
+
+	s = s.refresh()
+	switch {
+	case solved:
+		s.message = fmt.Sprintf("Solved in %d, ctrl+n to start over.", len(s.rows))
+	case len(s.candidates) == 0:
+		s.message = "No words fit those colours, check the rows (backspace takes the last one back)."
+	}
+
+	return s
+}
+
+func (s solverModel) View(st styles) string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render("Solver (esc to go back)"))
+	b.WriteString("\n")
+	b.WriteString("Type the guess you played elsewhere, then mark the colour of each letter.\n\n")
+
+	for _, row := range s.rows {
+		tiles := make([]string, len(row))
+		for i, c := range row {
+			tiles[i] = st.renderCell(c)
+		}
+		b.WriteString(joinTiles(tiles))
+		b.WriteString("\n")
+	}
+
+	tiles := make([]string, s.cfg.WordLength)
+	for i := range tiles {
+		if i < len(s.word) {
+			tiles[i] = st.renderTile(s.word[i], s.marks[i])
+		} else {
+			tiles[i] = st.renderTile(' ', game.StateEmpty)
+		}
+	}
+	b.WriteString(joinTiles(tiles))
+	b.WriteString("\n")
+
+	// point at the letter being marked
+	if len(s.word) > 0 {
+		width := lipgloss.Width(st.empty.Render(" "))
+		b.WriteString(strings.Repeat(" ", s.cursor*(width+1)+width/2))
+		b.WriteString("^")
+	}
+	b.WriteString("\n\n")
+
+	listed := s.candidates[:min(len(s.candidates), maxListedCandidates)]
+	possible := fmt.Sprintf("Possible words (%d): %s", len(s.candidates), strings.Join(listed, " "))
+	if len(s.candidates) > len(listed) {
+		possible += " ..."
+	}
+	b.WriteString(lipgloss.NewStyle().Width(60).Render(possible))
+	b.WriteString("\n")
+	if s.suggestion != "" {
+		b.WriteString(fmt.Sprintf("Suggested guess: %s (tab to use it)\n", s.suggestion))
+	}
+
+	if s.message != "" {
+		b.WriteString("\n")
+		b.WriteString(s.message)
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\nletters type, ←/→ select a letter, space or ↑/↓ change its colour,\n")
+	b.WriteString("1 grey, 2 yellow, 3 green, Enter adds the row, Backspace undoes, ctrl+n starts over.\n")
+
+	return b.String()
+}
+
+func (m model) updateSolver(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		return m.updateWindowSize(msg)
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+
+		solver, open := m.solver.Update(msg)
+		m.solver = solver
+		if !open {
+			m.screen = screenGame
+			return m, tea.ClearScreen
+		}
+	}
+
+	return m, nil
+}
@@ -0,0 +1,75 @@
+package tui
+
+import "github.com/charmbracelet/lipgloss"
+
+type styles struct {
+	tileHeight int
+
+	correct lipgloss.Style
+	present lipgloss.Style
+	absent  lipgloss.Style
+	empty   lipgloss.Style
+	// the empty tile under the cursor of the row being typed
+	cursor lipgloss.Style
+}
+
+type palette struct {
+	correctBg, correctFg string
+	presentBg, presentFg string
+	absentBg, absentFg   string
+	emptyBg, emptyFg     string
+}
+
+var themes = map[string]palette{
+	"dark": {
+		correctBg: "#6aaa64", correctFg: "#ffffff",
+		presentBg: "#c9b458", presentFg: "#000000",
+		absentBg: "#787c7e", absentFg: "#ffffff",
+		emptyBg: "#121212", emptyFg: "#888888",
+	},
+	"light": {
+		correctBg: "#6aaa64", correctFg: "#ffffff",
+		presentBg: "#c9b458", presentFg: "#ffffff",
+		absentBg: "#787c7e", absentFg: "#ffffff",
+		emptyBg: "#d3d6da", emptyFg: "#1a1a1b",
+	},
+	"high-contrast": {
+		correctBg: "#f5793a", correctFg: "#ffffff",
+		presentBg: "#85c0f9", presentFg: "#000000",
+		absentBg: "#3a3a3c", absentFg: "#ffffff",
+		emptyBg: "#000000", emptyFg: "#ffffff",
+	},
+}
+
+func newStyles(theme string) styles {
+	p, ok := themes[theme]
+	if !ok {
+		p = themes["dark"]
+	}
+
+	tile := func(bg, fg string) lipgloss.Style {
+		return lipgloss.NewStyle().Background(lipgloss.Color(bg)).Foreground(lipgloss.Color(fg)).Padding(0, 1)
+	}
+
+	return styles{
+		tileHeight: 1,
+		correct:    tile(p.correctBg, p.correctFg),
+		present:    tile(p.presentBg, p.presentFg),
+		absent:     tile(p.absentBg, p.absentFg),
+		empty:      tile(p.emptyBg, p.emptyFg),
+		cursor:     tile(p.emptyFg, p.emptyBg),
+	}
+}
+
+// large returns the styles with tiles three lines high for terminals with
+// room to spare.
+func (st styles) large() styles {
+	st.tileHeight = 3
+	st.correct = st.correct.Padding(1, 2)
+	st.present = st.present.Padding(1, 2)
+	st.absent = st.absent.Padding(1, 2)
+	st.empty = st.empty.Padding(1, 2)
+	st.cursor = st.cursor.Padding(1, 2)
+
+	return st
+}
@@ -2,49 +2,116 @@ package tui
 
 import (
 	"fmt"
-	"strings"
 
-	"koutaroyumiba/wordle/bot"
+	"koutaroyumiba/wordle/config"
 	"koutaroyumiba/wordle/game"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
+type screen int
+
 const (
-	wordLength = 5
-	maxGuesses = 6
+	screenGame screen = iota
+	screenSettings
+	screenStats
+	screenSolver
+	screenLeaderboard
+	screenReverse
+	screenDrills
 )
 
 var (
 	// styles
-	greenStyle  = lipgloss.NewStyle().Background(lipgloss.Color("#6aaa64")).Foreground(lipgloss.Color("#ffffff")).Padding(0, 1)
-	yellowStyle = lipgloss.NewStyle().Background(lipgloss.Color("#c9b458")).Foreground(lipgloss.Color("#000000")).Padding(0, 1)
-	grayStyle   = lipgloss.NewStyle().Background(lipgloss.Color("#787c7e")).Foreground(lipgloss.Color("#ffffff")).Padding(0, 1)
-	emptyStyle  = lipgloss.NewStyle().Background(lipgloss.Color("#121212")).Foreground(lipgloss.Color("#888888")).Padding(0, 1)
-
 	keyStyle    = lipgloss.NewStyle().Padding(0, 1).Border(lipgloss.RoundedBorder()).Margin(0, 1)
 	headerStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#ffffff")).MarginBottom(1)
 )
 
 type model struct {
-	gameState game.GameState
-	current   []rune
-	done      bool
-	win       bool
-	message   string
+	cfg        config.Config
+	configPath string
+	styles     styles
+	screen     screen
+	settings   settingsModel
+	solver     solverModel
+	gameState  game.GameState
+	keyboard   *keyboardZones
+	anim       animation
+	width      int
+	height     int
+	page       page
+	assist     assistLevel
+	suggestion string
+	current    []rune
+	done       bool
+	win        bool
+	message    string
+
+	// the game picked in the dashboard's recent games, newest is 0
+	historyCursor int
+	// what the last replay export of the dashboard wrote
+	exported    string
+	leaderboard leaderboardScreen
+	reverse     reverseModel
+	drills      drillModel
+
+	// words left after each guess, see refreshAnalysis
+	wordsLeft []int
+	leftWords [][]string
+	// the words that fit what is typed, see refreshCandidates
+	candidates []string
+
+	// where the next letter goes in current, and its edits for ctrl+z
+	cursor int
+	undo   []inputSnapshot
 }
 
-func InitialModel() model {
-	wordle := game.InitGame(wordLength, maxGuesses)
+// InitialModel starts a game with the given settings. configPath is where
+// the settings screen saves changes to.
+func InitialModel(cfg config.Config, configPath string) model {
+	wordle := cfg.NewGame()
+	assist := parseAssistLevel(cfg.Assistant)
+	if assist != assistOff {
+		wordle.MarkAssisted()
+	}
 
-	return model{
-		gameState: wordle,
-		current:   []rune{},
-		done:      false,
-		win:       false,
-		message:   "Type letters, Backspace to delete, Enter to submit.",
+	m := model{
+		cfg:        cfg,
+		configPath: configPath,
+		styles:     newStyles(cfg.Theme),
+		screen:     screenGame,
+		gameState:  wordle,
+		keyboard:   &keyboardZones{},
+		current:    []rune{},
+		done:       false,
+		win:        false,
+		assist:     assist,
+		message:    "Type letters, Backspace to delete, ←/→ to move, ctrl+z to undo, Enter to submit.",
+	}
+	if cfg.Reverse {
+		m.screen = screenReverse
+		m.reverse = newReverse(cfg)
+		// only the first screen, restarts play normal games
+		m.cfg.Reverse = false
 	}
+
+	return m.refreshAnalysis().refreshSuggestion()
+}
+
+// restart begins a new game, keeping what is known about the terminal.
+func (m model) restart() model {
+	next := InitialModel(m.cfg, m.configPath)
+	next.width, next.height, next.page = m.width, m.height, m.page
+	if next.assist != m.assist {
+		next.assist = m.assist
+		if m.assist != assistOff {
+			next.gameState.MarkAssisted()
+		}
+		next = next.refreshCandidates().refreshSuggestion()
+	}
+
+	return next
 }
 
 func (m model) Init() tea.Cmd {
@@ -52,13 +119,120 @@ func (m model) Init() tea.Cmd {
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// the board keeps animating while another screen is open, so it isn't
+	// left half revealed when the player comes back
+	if msg, ok := msg.(animationTickMsg); ok {
+		return m.updateAnimation(msg)
+	}
+
+	// the submit may finish after the player moved to another screen, the
+	// message waits for them on the game screen
+	if msg, ok := msg.(submittedMsg); ok {
+		if msg.err != nil {
+			m.message = fmt.Sprintf("could not submit to the leaderboard: %v", msg.err)
+			return m, nil
+		}
+		m.message = "submitted to the leaderboard, press l to see it"
+		if m.screen == screenLeaderboard {
+			// the board was fetched before the result got there
+			return m.openLeaderboard()
+		}
+		return m, nil
+	}
+
+	if m.screen == screenSettings {
+		return m.updateSettings(msg)
+	}
+	if m.screen == screenStats {
+		return m.updateDashboard(msg)
+	}
+	if m.screen == screenSolver {
+		return m.updateSolver(msg)
+	}
+	if m.screen == screenLeaderboard {
+		return m.updateLeaderboard(msg)
+	}
+	if m.screen == screenReverse {
+		return m.updateReverse(msg)
+	}
+	if m.screen == screenDrills {
+		return m.updateDrills(msg)
+	}
+
+	if msg, ok := msg.(tea.MouseMsg); ok {
+		return m.updateMouse(msg)
+	}
+
+	if msg, ok := msg.(tea.WindowSizeMsg); ok {
+		return m.updateWindowSize(msg)
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		if next, switched := m.updatePage(msg); switched {
+			return next, nil
+		}
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyCtrlA {
+		return m.cycleAssistant(), nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyTab {
+		m.screen = screenSettings
+		m.settings = newSettings(m.cfg)
+		return m, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyCtrlO {
+		// pick up where the solver was left, unless the settings changed
+		if m.solver.cfg.Language != m.cfg.Language || m.solver.cfg.WordLength != m.cfg.WordLength || m.solver.candidates == nil {
+			m.solver = newSolver(m.cfg)
+		}
+		m.screen = screenSolver
+		return m, tea.ClearScreen
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyCtrlR {
+		// keep the puzzle that was left, unless the settings changed
+		if m.reverse.cfg.Language != m.cfg.Language || m.reverse.cfg.WordLength != m.cfg.WordLength || m.reverse.puzzle.Answer == "" {
+			m.reverse = newReverse(m.cfg)
+		}
+		m.screen = screenReverse
+		return m, tea.ClearScreen
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyCtrlD {
+		// keep the drill that was left, unless the settings changed
+		if m.drills.cfg.Language != m.cfg.Language || m.drills.cfg.WordLength != m.cfg.WordLength || m.drills.progress == nil {
+			m.drills = newDrills(m.cfg)
+		}
+		m.screen = screenDrills
+		return m, tea.ClearScreen
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyCtrlL {
+		return m.openLeaderboard()
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyCtrlT {
+		m.screen = screenStats
+		m.historyCursor = 0
+		return m, tea.ClearScreen
+	}
+
 	if m.done {
 		// respond to q to quit or r to restart, or any key to exit
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
 			switch msg.String() {
 			case "r", "R":
-				return InitialModel(), tea.ClearScreen
+				return m.restart(), tea.ClearScreen
+			case "s", "S":
+				m.screen = screenStats
+				m.historyCursor = 0
+				return m, tea.ClearScreen
+			case "l", "L":
+				return m.openLeaderboard()
 			case "q", "Q", "ctrl+c":
 				return m, tea.Quit
 			}
@@ -68,25 +242,15 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if next, edited := m.updateInput(msg); edited {
+			return next, nil
+		}
 		switch msg.Type {
-		case tea.KeyRunes:
-			r := msg.Runes[0]
-			if len(m.current) < wordLength && ((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')) {
-				m.current = append(m.current, rune(strings.ToLower(string(r))[0]))
-				m.message = ""
-			}
-			return m, nil
-		case tea.KeyBackspace:
-			if len(m.current) > 0 {
-				m.current = m.current[:len(m.current)-1]
-			}
-			m.message = ""
-			return m, nil
 		case tea.KeyEnter:
 			// submit guess
-			if len(m.current) != wordLength {
-				m.message = fmt.Sprintf("Guess must be %d letters.", wordLength)
-				return m, nil
+			if len(m.current) != m.gameState.GetWordLength() {
+				m.message = fmt.Sprintf("Guess must be %d letters.", m.gameState.GetWordLength())
+				return m, m.startAnimation(animShake, m.gameState.GetAttempts())
 			}
 			guess := string(m.current)
 
@@ -94,12 +258,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			if !validateRes {
 				m.message = errMsg
-				return m, nil
+				return m, m.startAnimation(animShake, m.gameState.GetAttempts())
 			}
 
 			// evaluate
 			finished, won := m.gameState.ApplyGuess(guess)
-			m.current = []rune{}
+			m = m.clearInput()
 			m.message = ""
 
 			if finished {
@@ -108,6 +272,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if won {
 				m.win = true
 			}
+			m = m.refreshAnalysis().refreshSuggestion()
+			reveal := m.startAnimation(animReveal, m.gameState.GetAttempts()-1)
+			if finished && m.gameState.GetDaily() > 0 {
+				return m, tea.Batch(reveal, m.submitGame())
+			}
+			return m, reveal
 		case tea.KeyCtrlC:
 			return m, tea.Quit
 		}
@@ -115,132 +285,88 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-func renderCell(c game.Cell) string {
-	char, state := c.GetInfo()
-	ch := ' '
-	if char != ' ' && char != 0 {
-		ch = char
-	}
-	switch state {
-	case game.StateCorrect:
-		return greenStyle.Render(string(ch))
-	case game.StatePresent:
-		return yellowStyle.Render(string(ch))
-	case game.StateAbsent:
-		return grayStyle.Render(string(ch))
-	default:
-		return emptyStyle.Render(string(ch))
+func (m model) updateSettings(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		if size, ok := msg.(tea.WindowSizeMsg); ok {
+			return m.updateWindowSize(size)
+		}
+		return m, nil
 	}
-}
-
-func renderRow(cells []game.Cell) string {
-	parts := make([]string, len(cells))
-	for i, c := range cells {
-		parts[i] = renderCell(c)
+	if keyMsg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
 	}
-	return strings.Join(parts, " ")
-}
 
-func renderKeyboard(known map[rune]game.CellState) string {
-	// simple QWERTY rows
-	rows := []string{
-		"qwertyuiop",
-		"asdfghjkl",
-		"zxcvbnm",
-	}
-	outRows := make([]string, len(rows))
-	for ri, row := range rows {
-		parts := []string{}
-		for _, ch := range row {
-			s, ok := known[ch]
-			cellRep := string(ch)
-			switch {
-			case ok && s == game.StateCorrect:
-				parts = append(parts, greenStyle.Render(cellRep))
-			case ok && s == game.StatePresent:
-				parts = append(parts, yellowStyle.Render(cellRep))
-			case ok && s == game.StateAbsent:
-				parts = append(parts, grayStyle.Render(cellRep))
-			default:
-				parts = append(parts, emptyStyle.Render(cellRep))
-			}
+	settings, action := m.settings.Update(keyMsg)
+	m.settings = settings
+
+	switch action {
+	case settingsCancel:
+		m.screen = screenGame
+	case settingsSave:
+		// only what was edited here is saved, not flags given for this run
+		file, err := config.Load(m.configPath)
+		if err == nil {
+			err = settings.edited(file).Save(m.configPath)
+		}
+		if err != nil {
+			m.settings.message = fmt.Sprintf("could not save settings: %v", err)
+			return m, nil
 		}
-		outRows[ri] = strings.Join(parts, " ")
-	}
-	return strings.Join(outRows, "\n")
-}
 
-func (m model) View() string {
-	var b strings.Builder
-	b.WriteString(headerStyle.Render("Terminal Wordle (ctrl+c to exit)"))
-	b.WriteString("\n")
-
-	guesses := m.gameState.GetGuesses()
-	bot := bot.InitBot(wordLength, maxGuesses)
-	length, words := bot.Analysis(guesses)
-
-	// render guesses so far
-	for i := range maxGuesses {
-		b.WriteString(renderRow(m.gameState.GetCurrentBoardRow(m.current, i)))
-		b.WriteString(fmt.Sprintf("  no. of words left: %d", length[i]))
-		if m.done && len(words[i]) > 0 && len(words[i]) < 8 {
-			b.WriteString(" [")
-			for _, word := range words[i] {
-				b.WriteString(fmt.Sprintf(" %s ", word))
+		m.cfg = settings.cfg
+		m.styles = newStyles(m.cfg.Theme)
+		m.screen = screenGame
+		m = m.refreshAnalysis()
+		if level := parseAssistLevel(m.cfg.Assistant); level != m.assist {
+			m.assist = level
+			if level != assistOff {
+				m.gameState.MarkAssisted()
 			}
-			b.WriteString("]")
+			m = m.refreshCandidates().refreshSuggestion()
 		}
-		b.WriteString("\n\n")
-	}
 
-	// keyboard
-	b.WriteString("Keyboard:\n")
-	b.WriteString(renderKeyboard(m.gameState.GetKnown()))
-	b.WriteString("\n\n")
-
-	// message
-	if m.message != "" {
-		b.WriteString("msg: ")
-		b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff")).Render(m.message))
-		b.WriteString("\n")
+		// nothing to lose yet, so start over with the new board
+		if m.gameState.GetAttempts() == 0 && !m.done {
+			return m.restart(), tea.ClearScreen
+		}
+		m.message = "Settings saved, board changes apply from the next game."
 	}
 
-	winningStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#6aaa64"))
-	losingStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#ff5f87"))
-
-	stats := m.gameState.GetStats()
+	return m, nil
+}
 
-	if m.done {
-		if m.win {
-			b.WriteString(winningStyle.Render("\ncongrats\n"))
-		} else {
-			b.WriteString(losingStyle.Render(fmt.Sprintf("\ngg u suck, word: %s\n", m.gameState.GetAnswer())))
-		}
-		b.WriteString("\nPress r to play again, q to quit.\n")
+func (st styles) renderCell(c game.Cell) string {
+	char, state := c.GetInfo()
+	return st.renderTile(char, state)
+}
 
+func (st styles) renderTile(char rune, state game.CellState) string {
+	ch := ' '
+	if char != ' ' && char != 0 {
+		ch = char
 	}
-
-	b.WriteString("\n--- Statistics ---\n")
-	b.WriteString(fmt.Sprintf("Games Played: %d\n", stats.GamesPlayed))
-	b.WriteString(fmt.Sprintf("Wins: %d\n", stats.Wins))
-	b.WriteString(fmt.Sprintf("Win Rate: %.1f%%\n", stats.WinRate()))
-	b.WriteString(fmt.Sprintf("Current Streak: %d\n", stats.CurrentStreak))
-	b.WriteString(fmt.Sprintf("Max Streak: %d\n", stats.MaxStreak))
-	b.WriteString(fmt.Sprintf("Avg Guesses (wins): %.2f\n", stats.AverageGuesses()))
-
-	distribution := stats.GuessFrequency
-	total := 0
-	for _, c := range distribution {
-		total += c
+	switch state {
+	case game.StateCorrect:
+		return st.correct.Render(string(ch))
+	case game.StatePresent:
+		return st.present.Render(string(ch))
+	case game.StateAbsent:
+		return st.absent.Render(string(ch))
+	default:
+		return st.empty.Render(string(ch))
 	}
+}
 
-	for i := range maxGuesses {
-		count, ok := distribution[i+1]
-		if !ok {
-			count = 0
+// joinTiles puts tiles next to each other with a space between, tiles can
+// be more than one line high.
+func joinTiles(tiles []string) string {
+	parts := make([]string, 0, len(tiles)*2)
+	for i, tile := range tiles {
+		if i > 0 {
+			parts = append(parts, " ")
 		}
-		b.WriteString(fmt.Sprintf("%d : %s[%d]\n", i+1, strings.Repeat("#", int(float64(count)/float64(total)*30)), count))
+		parts = append(parts, tile)
 	}
-
-	return b.String()
+	return lipgloss.JoinHorizontal(lipgloss.Top, parts...)
 }
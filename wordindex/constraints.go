@@ -0,0 +1,76 @@
+package wordindex
+
+import "maps"
+
+// Constraints are what a word has to satisfy to still be the answer.
+type Constraints struct {
+	// letter required at each position, 0 when unknown
+	Fixed []rune
+	// letters ruled out at each position
+	Excluded []map[rune]bool
+	// least and most copies of a letter, Max only for letters with a known
+	// upper bound (0 for letters not in the answer at all)
+	Min map[rune]int
+	Max map[rune]int
+}
+
+// NewConstraints allows every word of the given length.
+func NewConstraints(length int) Constraints {
+	c := Constraints{
+		Fixed:    make([]rune, length),
+		Excluded: make([]map[rune]bool, length),
+		Min:      make(map[rune]int),
+		Max:      make(map[rune]int),
+	}
+	for i := range c.Excluded {
+		c.Excluded[i] = make(map[rune]bool)
+	}
+
+	return c
+}
+
+func (c Constraints) Clone() Constraints {
+	clone := Constraints{
+		Fixed:    append([]rune(nil), c.Fixed...),
+		Excluded: make([]map[rune]bool, len(c.Excluded)),
+		Min:      maps.Clone(c.Min),
+		Max:      maps.Clone(c.Max),
+	}
+	for i, ex := range c.Excluded {
+		clone.Excluded[i] = maps.Clone(ex)
+	}
+
+	return clone
+}
+
+// Matches checks a single word, Index.Filter does the same for a whole
+// word list at once.
+func (c Constraints) Matches(word []rune) bool {
+	if len(word) != len(c.Fixed) {
+		return false
+	}
+
+	counts := map[rune]int{}
+	for i, char := range word {
+		if c.Fixed[i] != 0 && c.Fixed[i] != char {
+			return false
+		}
+		if c.Excluded[i][char] {
+			return false
+		}
+		counts[char]++
+	}
+
+	for char, n := range c.Min {
+		if counts[char] < n {
+			return false
+		}
+	}
+	for char, n := range c.Max {
+		if counts[char] > n {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,209 @@
+package wordindex
+
+import (
+	"math/bits"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// bitset has one bit per word of an index.
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) set(i int) {
+	b[i/64] |= 1 << (i % 64)
+}
+
+func (b bitset) and(other bitset) {
+	for i := range b {
+		b[i] &= other[i]
+	}
+}
+
+func (b bitset) andNot(other bitset) {
+	for i := range b {
+		b[i] &^= other[i]
+	}
+}
+
+func (b bitset) clear() {
+	clear(b)
+}
+
+// Index is a word list prepared for fast lookups: a hash set for "is this
+// a word", a sorted copy for prefixes and per letter and position bitsets
+// that answer "which words fit these constraints" with a few bitwise
+// operations per constraint instead of checking every word.
+type Index struct {
+	words  []string
+	length int
+	set    map[string]struct{}
+	sorted []string
+	all    bitset
+	// words with the letter at a position
+	at []map[rune]bitset
+	// atLeast[r][n-1] are the words with at least n copies of r
+	atLeast map[rune][]bitset
+}
+
+// New indexes the words of the given length, others are never matched.
+func New(words []string, length int) *Index {
+	ix := &Index{
+		words:   words,
+		length:  length,
+		set:     make(map[string]struct{}, len(words)),
+		sorted:  slices.Sorted(slices.Values(words)),
+		all:     newBitset(len(words)),
+		at:      make([]map[rune]bitset, length),
+		atLeast: make(map[rune][]bitset),
+	}
+	for i := range ix.at {
+		ix.at[i] = make(map[rune]bitset)
+	}
+
+	for w, word := range words {
+		ix.set[word] = struct{}{}
+		if utf8.RuneCountInString(word) != length {
+			continue
+		}
+		ix.all.set(w)
+
+		counts := map[rune]int{}
+		for i, char := range []rune(word) {
+			if ix.at[i][char] == nil {
+				ix.at[i][char] = newBitset(len(words))
+			}
+			ix.at[i][char].set(w)
+			counts[char]++
+		}
+
+		for char, n := range counts {
+			if ix.atLeast[char] == nil {
+				ix.atLeast[char] = make([]bitset, length)
+			}
+			for k := range n {
+				if ix.atLeast[char][k] == nil {
+					ix.atLeast[char][k] = newBitset(len(words))
+				}
+				ix.atLeast[char][k].set(w)
+			}
+		}
+	}
+
+	return ix
+}
+
+var (
+	sharedMu sync.Mutex
+	shared   = map[sharedKey]*Index{}
+)
+
+type sharedKey struct {
+	first  *string
+	size   int
+	length int
+}
+
+// Shared returns the index of a word list, building it the first time.
+// word lists are package level slices that never change, so the list is
+// recognised by where it is stored.
+func Shared(words []string, length int) *Index {
+	if len(words) == 0 {
+		return New(words, length)
+	}
+
+	key := sharedKey{first: &words[0], size: len(words), length: length}
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	ix, ok := shared[key]
+	if !ok {
+		ix = New(words, length)
+		shared[key] = ix
+	}
+
+	return ix
+}
+
+func (ix *Index) Words() []string {
+	return ix.words
+}
+
+// Contains reports whether word is in the list.
+func (ix *Index) Contains(word string) bool {
+	_, ok := ix.set[word]
+	return ok
+}
+
+// WithPrefix returns the words starting with prefix in sorted order.
+func (ix *Index) WithPrefix(prefix string) []string {
+	start, _ := slices.BinarySearch(ix.sorted, prefix)
+	end := start
+	for end < len(ix.sorted) && strings.HasPrefix(ix.sorted[end], prefix) {
+		end++
+	}
+
+	return ix.sorted[start:end]
+}
+
+// Filter returns the words that satisfy c, in the order they were indexed.
+func (ix *Index) Filter(c Constraints) []string {
+	if len(c.Fixed) != ix.length {
+		return []string{}
+	}
+	match := append(bitset(nil), ix.all...)
+
+	for i, char := range c.Fixed {
+		if char == 0 {
+			continue
+		}
+		if set, ok := ix.at[i][char]; ok {
+			match.and(set)
+		} else {
+			match.clear()
+		}
+	}
+	for i, excluded := range c.Excluded {
+		for char := range excluded {
+			if set, ok := ix.at[i][char]; ok {
+				match.andNot(set)
+			}
+		}
+	}
+	for char, n := range c.Min {
+		if n <= 0 {
+			continue
+		}
+		if n > ix.length || ix.atLeast[char] == nil || ix.atLeast[char][n-1] == nil {
+			match.clear()
+			continue
+		}
+		match.and(ix.atLeast[char][n-1])
+	}
+	for char, n := range c.Max {
+		// words with n+1 copies or more are out
+		if n < ix.length && ix.atLeast[char] != nil && ix.atLeast[char][n] != nil {
+			match.andNot(ix.atLeast[char][n])
+		}
+	}
+
+	return ix.collect(match)
+}
+
+func (ix *Index) collect(match bitset) []string {
+	out := []string{}
+	for i, chunk := range match {
+		for chunk != 0 {
+			bit := bits.TrailingZeros64(chunk)
+			out = append(out, ix.words[i*64+bit])
+			chunk &= chunk - 1
+		}
+	}
+
+	return out
+}